@@ -3,8 +3,10 @@ package goodreads
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // DefaultAPIRoot specifies a root for the client, which we point at goodreads.com.
@@ -22,6 +24,7 @@ var defaultAPIClient APIClient = &httpClient{
 // and decode the response to a local struct.
 type APIClient interface {
 	Get(string, func([]byte, interface{}) error, url.Values, interface{}) error
+	Do(string, string, func([]byte, interface{}) error, url.Values, interface{}) error
 }
 
 type httpClient struct {
@@ -31,12 +34,34 @@ type httpClient struct {
 }
 
 func (h *httpClient) Get(endpoint string, decoder func([]byte, interface{}) error, q url.Values, v interface{}) error {
-	url := fmt.Sprintf("%s/%s?%s", h.APIRoot, endpoint, q.Encode())
+	return h.Do(http.MethodGet, endpoint, decoder, q, v)
+}
+
+// Do performs a request using the given HTTP method. Parameters are sent in the
+// query string for GET requests, and as a form-encoded body otherwise.
+//
+// If v is nil the response body is discarded rather than decoded.
+func (h *httpClient) Do(method, endpoint string, decoder func([]byte, interface{}) error, q url.Values, v interface{}) error {
+	url := fmt.Sprintf("%s/%s", h.APIRoot, endpoint)
+	var body io.Reader
+	if method == http.MethodGet {
+		url = fmt.Sprintf("%s?%s", url, q.Encode())
+	} else {
+		body = strings.NewReader(q.Encode())
+	}
 	if h.Verbose {
-		fmt.Printf("GET %s\n", url)
+		fmt.Printf("%s %s\n", method, url)
 	}
 
-	res, err := h.Client.Get(url)
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	res, err := h.Client.Do(req)
 	if err != nil {
 		return err
 	}
@@ -53,5 +78,8 @@ func (h *httpClient) Get(endpoint string, decoder func([]byte, interface{}) erro
 		return fmt.Errorf("unexpected response code: %d", res.StatusCode)
 	}
 
+	if v == nil {
+		return nil
+	}
 	return decoder(buf.Bytes(), v)
 }
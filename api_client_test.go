@@ -43,3 +43,30 @@ func TestHttpClient_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestHttpClient_Do(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/foo/bar", r.URL.String())
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "v1", r.PostForm.Get("p1"))
+		_, _ = w.Write([]byte(`<response><id>SampleID</id></response>`))
+	}))
+	defer s.Close()
+
+	v := url.Values{}
+	v.Set("p1", "v1")
+	var res struct {
+		ID string `xml:"id"`
+	}
+	h := httpClient{Client: http.DefaultClient, APIRoot: s.URL, Verbose: true}
+	err := h.Do(http.MethodPost, "foo/bar", xml.Unmarshal, v, &res)
+	assert.Nil(t, err)
+	assert.Equal(t, "SampleID", res.ID)
+
+	t.Run("with nil result", func(t *testing.T) {
+		err := h.Do(http.MethodPost, "foo/bar", xml.Unmarshal, v, nil)
+		assert.Nil(t, err)
+	})
+}
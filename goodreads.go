@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"github.com/KyleBanks/goodreads/responses"
 	"github.com/KyleBanks/goodreads/responses/work"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -28,6 +29,93 @@ func NewClient(key string) *Client {
 	}
 }
 
+// NewAuthenticatedClient initializes a Client that performs requests using
+// the provided http.Client. This is required for methods that act on behalf of
+// a user, and the http.Client is expected to sign each request with the user's
+// OAuth credentials, such as one produced by an OAuth 1.0a library.
+func NewAuthenticatedClient(key string, client *http.Client) *Client {
+	return &Client{
+		APIKey: key,
+		httpClient: &httpClient{
+			Client:  client,
+			APIRoot: defaultAPIRoot,
+		},
+	}
+}
+
+// AuthUser returns the ID, name and link of the authenticated user.
+// https://www.goodreads.com/api/index#auth.user
+func (c *Client) AuthUser() (*responses.User, error) {
+	var r struct {
+		User struct {
+			ID   string `xml:"id,attr"`
+			Name string `xml:"name"`
+			Link string `xml:"link"`
+		} `xml:"user"`
+	}
+	err := c.httpClient.Get("api/auth_user", xml.Unmarshal, c.defaultValues(), &r)
+	if err != nil {
+		return nil, err
+	}
+	return &responses.User{
+		ID:   r.User.ID,
+		Name: r.User.Name,
+		Link: r.User.Link,
+	}, nil
+}
+
+// AuthUserShow returns the public information about the authenticated user.
+func (c *Client) AuthUserShow() (*responses.User, error) {
+	u, err := c.AuthUser()
+	if err != nil {
+		return nil, err
+	}
+	return c.UserShow(u.ID)
+}
+
+// AuthUserShelves returns the list of shelves belonging to the authenticated user.
+func (c *Client) AuthUserShelves() ([]responses.UserShelf, error) {
+	u, err := c.AuthUser()
+	if err != nil {
+		return nil, err
+	}
+	return c.ShelvesList(u.ID)
+}
+
+// AuthorByName looks up an author by name, returning only
+// their ID, name and link.
+// https://www.goodreads.com/api/index#api.author_url
+func (c *Client) AuthorByName(name string) (*responses.Author, error) {
+	var r struct {
+		Author struct {
+			ID   string `xml:"id,attr"`
+			Name string `xml:"name"`
+			Link string `xml:"link"`
+		} `xml:"author"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("api/author_url/%s", url.PathEscape(name)), xml.Unmarshal, c.defaultValues(), &r)
+	if err != nil {
+		return nil, err
+	}
+	if r.Author.ID == "" {
+		return nil, fmt.Errorf("author not found: %s", name)
+	}
+	return &responses.Author{
+		ID:   r.Author.ID,
+		Name: r.Author.Name,
+		Link: r.Author.Link,
+	}, nil
+}
+
+// AuthorShowByName looks up an author by name and returns their full details.
+func (c *Client) AuthorShowByName(name string) (*responses.Author, error) {
+	a, err := c.AuthorByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return c.AuthorShow(a.ID)
+}
+
 // AuthorBooks returns a list of books by a particular author.
 // https://www.goodreads.com/api/index#author.books
 func (c *Client) AuthorBooks(authorID string, page int) (*responses.Author, error) {
@@ -59,6 +147,140 @@ func (c *Client) AuthorShow(authorID string) (*responses.Author, error) {
 	return &r.Author, nil
 }
 
+// CommentList returns a page of the comments on a resource.
+// https://www.goodreads.com/api/index#comment.list
+func (c *Client) CommentList(resource CommentResource, id string, page int) ([]responses.Comment, error) {
+	v := c.defaultValues()
+	v.Set("type", string(resource))
+	v.Set("id", id)
+	if page > 0 {
+		v.Set("page", strconv.Itoa(page))
+	}
+
+	var r struct {
+		Comments []responses.Comment `xml:"comments>comment"`
+	}
+	err := c.httpClient.Get("comment/index.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Comments, nil
+}
+
+// CommentCreate comments on a resource on behalf of the authenticated user.
+// https://www.goodreads.com/api/index#comment.create
+func (c *Client) CommentCreate(resource CommentResource, id, body string) (*responses.Comment, error) {
+	v := c.defaultValues()
+	v.Set("type", string(resource))
+	v.Set("id", id)
+	v.Set("comment[body]", body)
+
+	var r responses.Comment
+	err := c.httpClient.Do(http.MethodPost, "comment.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// EventsList returns the upcoming events near a location, given either as a
+// latitude and longitude or as a country and postal code.
+// https://www.goodreads.com/api/index#events.list
+func (c *Client) EventsList(lat, lng float64, countryCode, postalCode string) ([]responses.Event, error) {
+	v := c.defaultValues()
+	if lat != 0 || lng != 0 {
+		v.Set("lat", strconv.FormatFloat(lat, 'f', -1, 64))
+		v.Set("lng", strconv.FormatFloat(lng, 'f', -1, 64))
+	}
+	if countryCode != "" {
+		v.Set("search[country_code]", countryCode)
+	}
+	if postalCode != "" {
+		v.Set("search[postal_code]", postalCode)
+	}
+
+	var r struct {
+		Events []responses.Event `xml:"events>event"`
+	}
+	err := c.httpClient.Get("event/index.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Events, nil
+}
+
+// FollowAuthor follows an author on behalf of the authenticated user.
+// https://www.goodreads.com/api/index#author_following.create
+func (c *Client) FollowAuthor(authorID responses.AuthorID) (*responses.AuthorFollowing, error) {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+	v.Set("id", string(authorID))
+
+	var r responses.AuthorFollowing
+	err := c.httpClient.Do(http.MethodPost, "author_followings", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// UnfollowAuthor stops the authenticated user from following an author. The
+// ID is that of the following itself, as returned by FollowAuthor, rather than
+// of the author.
+// https://www.goodreads.com/api/index#author_following.destroy
+func (c *Client) UnfollowAuthor(followingID string) error {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+	return c.httpClient.Do(http.MethodDelete, fmt.Sprintf("author_followings/%s", followingID), xml.Unmarshal, v, nil)
+}
+
+// AuthorFollowing returns the details of a user following an author.
+// https://www.goodreads.com/api/index#author_following.show
+func (c *Client) AuthorFollowing(id string) (*responses.AuthorFollowing, error) {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+
+	var r responses.AuthorFollowing
+	err := c.httpClient.Get(fmt.Sprintf("author_followings/%s", id), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// FanshipCreate becomes a fan of an author on behalf of the authenticated user.
+// https://www.goodreads.com/api/index#fanships.create
+func (c *Client) FanshipCreate(authorID responses.AuthorID) (*responses.Fanship, error) {
+	v := c.defaultValues()
+	v.Set("fanship[author_id]", string(authorID))
+
+	var r responses.Fanship
+	err := c.httpClient.Do(http.MethodPost, "fanships.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// FanshipDestroy stops the authenticated user from being a fan of an author.
+// https://www.goodreads.com/api/index#fanships.destroy
+func (c *Client) FanshipDestroy(authorID responses.AuthorID) error {
+	v := c.defaultValues()
+	v.Set("fanship[author_id]", string(authorID))
+	return c.httpClient.Do(http.MethodDelete, "fanships/destroy.xml", xml.Unmarshal, v, nil)
+}
+
+// FanshipShow returns the details of a user being a fan of an author.
+// https://www.goodreads.com/api/index#fanships.show
+func (c *Client) FanshipShow(id string) (*responses.Fanship, error) {
+	var r responses.Fanship
+	err := c.httpClient.Get(fmt.Sprintf("fanships/show/%s.xml", id), xml.Unmarshal, c.defaultValues(), &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
 // BookReviewCounts returns the review statistics for a given list of ISBNs.
 // https://www.goodreads.com/api/index#book.review_counts
 func (c *Client) BookReviewCounts(isbns []string) ([]responses.ReviewCounts, error) {
@@ -74,6 +296,395 @@ func (c *Client) BookReviewCounts(isbns []string) ([]responses.ReviewCounts, err
 	return r.ReviewCounts, nil
 }
 
+// BookShow returns the full details of a book, including its
+// rating distribution and popular shelves.
+// https://www.goodreads.com/api/index#book.show
+func (c *Client) BookShow(bookID responses.BookID) (*responses.AuthorBook, error) {
+	var r struct {
+		Book responses.AuthorBook `xml:"book"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("book/show/%s.xml", bookID), xml.Unmarshal, c.defaultValues(), &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Book, nil
+}
+
+// FriendsList returns a page of a user's friends. The sort
+// is optional and defaults to the order chosen by Goodreads.
+// https://www.goodreads.com/api/index#friends.list
+func (c *Client) FriendsList(userID responses.UserID, page int, sort FriendsSort) ([]responses.User, error) {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+	if page > 0 {
+		v.Set("page", strconv.Itoa(page))
+	}
+	if sort != "" {
+		v.Set("sort", string(sort))
+	}
+
+	var r struct {
+		Friends []responses.User `xml:"friends>user"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("friend/user/%s", userID), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Friends, nil
+}
+
+// FriendRequests returns a page of the authenticated user's pending friend requests.
+// https://www.goodreads.com/api/index#friend.requests
+func (c *Client) FriendRequests(page int) ([]responses.FriendRequest, error) {
+	v := c.defaultValues()
+	if page > 0 {
+		v.Set("page", strconv.Itoa(page))
+	}
+
+	var r struct {
+		Requests []responses.FriendRequest `xml:"requests>friend_request"`
+	}
+	err := c.httpClient.Get("friend/requests.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Requests, nil
+}
+
+// FriendConfirmRequest accepts or declines a pending friend request.
+// https://www.goodreads.com/api/index#friend.confirm_request
+func (c *Client) FriendConfirmRequest(id string, accept bool) error {
+	v := c.defaultValues()
+	v.Set("id", id)
+	if accept {
+		v.Set("response", "Y")
+	} else {
+		v.Set("response", "N")
+	}
+	return c.httpClient.Do(http.MethodPost, "friend/confirm_request.xml", xml.Unmarshal, v, nil)
+}
+
+// GroupList returns the groups that a user is a member of. The sort
+// is optional and defaults to the order chosen by Goodreads.
+// https://www.goodreads.com/api/index#group.list
+func (c *Client) GroupList(userID responses.UserID, sort GroupSort) ([]responses.Group, error) {
+	v := c.defaultValues()
+	if sort != "" {
+		v.Set("sort", string(sort))
+	}
+
+	var r struct {
+		Groups []responses.Group `xml:"groups>list>group"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("group/list/%s.xml", userID), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Groups, nil
+}
+
+// GroupSearch returns a page of groups matching a query by title or description.
+// https://www.goodreads.com/api/index#group.search
+func (c *Client) GroupSearch(query string, page int) ([]responses.Group, error) {
+	v := c.defaultValues()
+	v.Set("q", query)
+	if page > 0 {
+		v.Set("page", strconv.Itoa(page))
+	}
+
+	var r struct {
+		Groups []responses.Group `xml:"groups>list>group"`
+	}
+	err := c.httpClient.Get("group/search.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Groups, nil
+}
+
+// GroupShow returns the full details of a group.
+// https://www.goodreads.com/api/index#group.show
+func (c *Client) GroupShow(id string) (*responses.Group, error) {
+	var r struct {
+		Group responses.Group `xml:"group"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("group/show/%s.xml", id), xml.Unmarshal, c.defaultValues(), &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Group, nil
+}
+
+// GroupMembers returns a page of the members of a group. The sort and
+// query, which filters members by name, are optional.
+// https://www.goodreads.com/api/index#group.members
+func (c *Client) GroupMembers(id string, page int, sort GroupMembersSort, query string) ([]responses.GroupMember, error) {
+	v := c.defaultValues()
+	if page > 0 {
+		v.Set("page", strconv.Itoa(page))
+	}
+	if sort != "" {
+		v.Set("sort", string(sort))
+	}
+	if query != "" {
+		v.Set("q", query)
+	}
+
+	var r struct {
+		Members []responses.GroupMember `xml:"group_users>group_user"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("group/members/%s.xml", id), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Members, nil
+}
+
+// GroupJoin adds the authenticated user to a group.
+// https://www.goodreads.com/api/index#group.join
+func (c *Client) GroupJoin(id string) error {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+	v.Set("id", id)
+	return c.httpClient.Do(http.MethodPost, "group/join", xml.Unmarshal, v, nil)
+}
+
+// ListsForBook returns the Listopia lists that a book appears on, along
+// with the book's rank and number of votes on each.
+// https://www.goodreads.com/api/index#list.book
+func (c *Client) ListsForBook(bookID responses.BookID) ([]responses.List, error) {
+	var r struct {
+		Lists []responses.List `xml:"lists>list"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("list/book/%s.xml", bookID), xml.Unmarshal, c.defaultValues(), &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Lists, nil
+}
+
+// Notifications returns a page of the authenticated user's notifications.
+// https://www.goodreads.com/api/index#notifications
+func (c *Client) Notifications(page int) ([]responses.Notification, error) {
+	v := c.defaultValues()
+	if page > 0 {
+		v.Set("page", strconv.Itoa(page))
+	}
+
+	var r struct {
+		Notifications []responses.Notification `xml:"notifications>notification"`
+	}
+	err := c.httpClient.Get("notifications.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Notifications, nil
+}
+
+// OwnedBooksList returns a page of the physical books owned by a user.
+// https://www.goodreads.com/api/index#owned_books.list
+func (c *Client) OwnedBooksList(userID responses.UserID, page int) ([]responses.OwnedBook, error) {
+	v := c.defaultValues()
+	if page > 0 {
+		v.Set("page", strconv.Itoa(page))
+	}
+
+	var r struct {
+		OwnedBooks []responses.OwnedBook `xml:"owned_books>owned_book"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("owned_books/user/%s.xml", userID), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.OwnedBooks, nil
+}
+
+// OwnedBookShow returns the details of a physical book owned by a user.
+// https://www.goodreads.com/api/index#owned_books.show
+func (c *Client) OwnedBookShow(id string) (*responses.OwnedBook, error) {
+	var r struct {
+		OwnedBook responses.OwnedBook `xml:"owned_book"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("owned_books/show/%s.xml", id), xml.Unmarshal, c.defaultValues(), &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r.OwnedBook, nil
+}
+
+// OwnedBookCreate adds a physical copy of a book to the authenticated user's owned books.
+// https://www.goodreads.com/api/index#owned_books.create
+func (c *Client) OwnedBookCreate(bookID responses.BookID, opts OwnedBookOptions) (*responses.OwnedBook, error) {
+	v := c.defaultValues()
+	v.Set("owned_book[book_id]", string(bookID))
+	opts.setValues(v)
+
+	var r responses.OwnedBook
+	err := c.httpClient.Do(http.MethodPost, "owned_books.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// OwnedBookUpdate updates the details of one of the authenticated user's owned books.
+// https://www.goodreads.com/api/index#owned_books.update
+func (c *Client) OwnedBookUpdate(id string, opts OwnedBookOptions) (*responses.OwnedBook, error) {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+	v.Set("id", id)
+	opts.setValues(v)
+
+	var r responses.OwnedBook
+	err := c.httpClient.Do(http.MethodPut, "owned_books/update", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// OwnedBookDestroy removes a book from the authenticated user's owned books.
+// https://www.goodreads.com/api/index#owned_books.destroy
+func (c *Client) OwnedBookDestroy(id string) error {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+	return c.httpClient.Do(http.MethodDelete, fmt.Sprintf("owned_books/destroy/%s", id), xml.Unmarshal, v, nil)
+}
+
+// QuoteCreate adds a quote on behalf of the authenticated user. The author's
+// name is required, while the author ID, book ID, tags and ISBN are optional.
+// When an ISBN is given, it's used to look up the book if no book ID is given.
+// https://www.goodreads.com/api/index#quotes.create
+func (c *Client) QuoteCreate(authorName string, authorID responses.AuthorID, bookID responses.BookID, body string, tags []string, isbn string) (*responses.Quote, error) {
+	v := c.defaultValues()
+	v.Set("quote[author_name]", authorName)
+	v.Set("quote[body]", body)
+	if authorID != "" {
+		v.Set("quote[author_id]", string(authorID))
+	}
+	if bookID != "" {
+		v.Set("quote[book_id]", string(bookID))
+	}
+	if len(tags) > 0 {
+		v.Set("quote[tags]", strings.Join(tags, ","))
+	}
+	if isbn != "" {
+		v.Set("isbn", isbn)
+	}
+
+	var r responses.Quote
+	err := c.httpClient.Do(http.MethodPost, "quotes.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// RatingCreate rates a book on behalf of the authenticated user.
+// The rating must be between 0 and 5, where 0 means no rating.
+// https://www.goodreads.com/api/index#rating.create
+func (c *Client) RatingCreate(bookID responses.BookID, rating int) error {
+	if rating < 0 || rating > 5 {
+		return fmt.Errorf("invalid rating %d: must be between 0 and 5", rating)
+	}
+
+	v := c.defaultValues()
+	v.Set("format", "xml")
+	v.Set("book_id", string(bookID))
+	v.Set("rating", strconv.Itoa(rating))
+	return c.httpClient.Do(http.MethodPost, "rating", xml.Unmarshal, v, nil)
+}
+
+// RatingDestroy removes the authenticated user's rating of a book.
+// https://www.goodreads.com/api/index#rating.destroy
+func (c *Client) RatingDestroy(bookID responses.BookID) error {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+	v.Set("book_id", string(bookID))
+	return c.httpClient.Do(http.MethodDelete, "rating", xml.Unmarshal, v, nil)
+}
+
+// ReadStatusShow returns the details of a change in a user's reading status.
+// https://www.goodreads.com/api/index#read_statuses.show
+func (c *Client) ReadStatusShow(id string) (*responses.ReadStatus, error) {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+
+	var r struct {
+		ReadStatus responses.ReadStatus `xml:"read_status"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("read_statuses/%s", id), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r.ReadStatus, nil
+}
+
+// RecommendationShow returns the details of a book recommended by one user to another.
+// https://www.goodreads.com/api/index#recommendations.show
+func (c *Client) RecommendationShow(id string) (*responses.Recommendation, error) {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+
+	var r struct {
+		Recommendation responses.Recommendation `xml:"recommendation"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("recommendations/%s", id), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Recommendation, nil
+}
+
+// ReviewCreate adds a review of a book for the authenticated user.
+// The rating must be between 0 and 5, where 0 means no rating,
+// and readAt is formatted as YYYY-MM-DD.
+// https://www.goodreads.com/api/index#review.create
+func (c *Client) ReviewCreate(bookID responses.BookID, body string, rating int, readAt, shelf string) (*responses.Review, error) {
+	v, err := c.reviewValues(body, &rating, readAt, shelf)
+	if err != nil {
+		return nil, err
+	}
+	v.Set("book_id", string(bookID))
+
+	var r responses.Review
+	err = c.httpClient.Do(http.MethodPost, "review.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ReviewEdit updates an existing review of the authenticated user.
+// The rating is only changed when given, and must be between 0 and 5,
+// where 0 means no rating. Empty fields are left unchanged, and readAt
+// is formatted as YYYY-MM-DD.
+// https://www.goodreads.com/api/index#review.edit
+func (c *Client) ReviewEdit(reviewID, body string, rating *int, readAt, shelf string, finished bool) (*responses.Review, error) {
+	v, err := c.reviewValues(body, rating, readAt, shelf)
+	if err != nil {
+		return nil, err
+	}
+	if finished {
+		v.Set("finished", "true")
+	}
+
+	var r responses.Review
+	err = c.httpClient.Do(http.MethodPut, fmt.Sprintf("review/%s.xml", reviewID), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ReviewDestroy deletes the authenticated user's review of a book.
+// https://www.goodreads.com/api/index#review.destroy
+func (c *Client) ReviewDestroy(bookID responses.BookID) error {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+	return c.httpClient.Do(http.MethodDelete, fmt.Sprintf("review/destroy/%s", bookID), xml.Unmarshal, v, nil)
+}
+
 // ReviewList returns the books on a members shelf.
 // https://www.goodreads.com/api/index#reviews.list
 func (c *Client) ReviewList(userID, shelf, sort, search, order string, page, perPage int) ([]responses.Review, error) {
@@ -108,27 +719,107 @@ func (c *Client) ReviewList(userID, shelf, sort, search, order string, page, per
 	return r.Reviews, nil
 }
 
+// ReviewShow returns a review along with a page of its comments.
+// https://www.goodreads.com/api/index#review.show
+func (c *Client) ReviewShow(id string, commentsPage int) (*responses.Review, error) {
+	v := c.defaultValues()
+	v.Set("id", id)
+	if commentsPage > 0 {
+		v.Set("page", strconv.Itoa(commentsPage))
+	}
+
+	var r struct {
+		Review responses.Review `xml:"review"`
+	}
+	err := c.httpClient.Get("review/show.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Review, nil
+}
+
+// ReviewByUserAndBook returns a user's review of a given book.
+// https://www.goodreads.com/api/index#review.show_by_user_and_book
+func (c *Client) ReviewByUserAndBook(userID responses.UserID, bookID responses.BookID) (*responses.Review, error) {
+	v := c.defaultValues()
+	v.Set("user_id", string(userID))
+	v.Set("book_id", string(bookID))
+
+	var r struct {
+		Review responses.Review `xml:"review"`
+	}
+	err := c.httpClient.Get("review/show_by_user_and_book.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Review, nil
+}
+
+// RecentReviews returns the most recent reviews from across Goodreads.
+// https://www.goodreads.com/api/index#review.recent_reviews
+func (c *Client) RecentReviews() ([]responses.Review, error) {
+	var r struct {
+		Reviews []responses.Review `xml:"reviews>review"`
+	}
+	err := c.httpClient.Get("review/recent_reviews.xml", xml.Unmarshal, c.defaultValues(), &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Reviews, nil
+}
+
 // SearchBooks returns a list of books based on a query string
 // by title, author, or ISBN.
 // https://www.goodreads.com/api/index#search.books
+//
+// Deprecated: SearchBooks returns the duplicate work types. Use SearchWorks.
 func (c *Client) SearchBooks(query string, page int, field SearchField) ([]work.Work, error) {
-	v := c.defaultValues()
-	v.Set("q", query)
-	v.Set("search[field]", string(field))
-	if page != 0 {
-		v.Set("page", strconv.Itoa(page))
+	return c.searchWorks(query, page, field)
+}
+
+// SearchWorks returns a list of works based on a query string
+// by title, author, or ISBN.
+// https://www.goodreads.com/api/index#search.books
+func (c *Client) SearchWorks(query string, page int, field SearchField) ([]responses.Work, error) {
+	found, err := c.searchWorks(query, page, field)
+	if err != nil {
+		return nil, err
 	}
 
-	var r struct {
-		Works []work.Work `xml:"search>results>work"`
+	works := make([]responses.Work, len(found))
+	for i, w := range found {
+		works[i] = w.ToWork()
 	}
+	return works, nil
+}
 
-	err := c.httpClient.Get("search/index.xml", xml.Unmarshal, v, &r)
+// CreateShelf adds a new shelf to the authenticated user's account.
+// https://www.goodreads.com/api/index#user_shelves.create
+func (c *Client) CreateShelf(name string, opts ShelfOptions) (*responses.UserShelf, error) {
+	v := c.defaultValues()
+	opts.setValues(v, name)
+
+	var r responses.UserShelf
+	err := c.httpClient.Do(http.MethodPost, "user_shelves.xml", xml.Unmarshal, v, &r)
 	if err != nil {
 		return nil, err
 	}
+	return &r, nil
+}
 
-	return r.Works, nil
+// UpdateShelf edits an existing shelf on the authenticated user's account.
+// Only the options that are set are changed, and the name is kept when empty.
+// https://www.goodreads.com/api/index#user_shelves.update
+func (c *Client) UpdateShelf(shelfID, name string, opts ShelfOptions) (*responses.UserShelf, error) {
+	v := c.defaultValues()
+	opts.setValues(v, name)
+
+	var r responses.UserShelf
+	err := c.httpClient.Do(http.MethodPut, fmt.Sprintf("user_shelves/%s.xml", shelfID), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
 
 // ShelvesList returns the list of shelves belonging to a user.
@@ -146,6 +837,217 @@ func (c *Client) ShelvesList(userID string) ([]responses.UserShelf, error) {
 	return r.Shelves, nil
 }
 
+// TopicShow returns a discussion topic along with its comments.
+// https://www.goodreads.com/api/index#topic.show
+func (c *Client) TopicShow(id string) (*responses.Topic, error) {
+	v := c.defaultValues()
+	v.Set("id", id)
+
+	var r struct {
+		Topic responses.Topic `xml:"topic"`
+	}
+	err := c.httpClient.Get("topic/show.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Topic, nil
+}
+
+// TopicGroupFolder returns a page of the discussion topics within a group's folder.
+// https://www.goodreads.com/api/index#topic.group_folder
+func (c *Client) TopicGroupFolder(folderID, groupID string, page int) ([]responses.Topic, error) {
+	v := c.defaultValues()
+	v.Set("group_id", groupID)
+	if page > 0 {
+		v.Set("page", strconv.Itoa(page))
+	}
+
+	var r struct {
+		Topics []responses.Topic `xml:"group_folder>topics>topic"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("topic/group_folder/%s.xml", folderID), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Topics, nil
+}
+
+// TopicUnreadGroup returns the discussion topics within a group that have
+// unread comments for the authenticated user.
+// https://www.goodreads.com/api/index#topic.unread_group
+func (c *Client) TopicUnreadGroup(groupID string) ([]responses.Topic, error) {
+	var r struct {
+		Topics []responses.Topic `xml:"group_folder>topics>topic"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("topic/unread_group/%s.xml", groupID), xml.Unmarshal, c.defaultValues(), &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Topics, nil
+}
+
+// TopicCreate starts a new discussion topic on behalf of the authenticated
+// user. The folder is only used for group topics and may be empty, and
+// question marks the topic as a question for the group.
+// https://www.goodreads.com/api/index#topic.create
+func (c *Client) TopicCreate(subject TopicSubject, subjectID, folderID, title, body string, question bool) (*responses.Topic, error) {
+	v := c.defaultValues()
+	v.Set("topic[subject_type]", string(subject))
+	v.Set("topic[subject_id]", subjectID)
+	if folderID != "" {
+		v.Set("topic[folder_id]", folderID)
+	}
+	v.Set("topic[title]", title)
+	v.Set("topic[question_flag]", strconv.FormatBool(question))
+	v.Set("comment[body_usertext]", body)
+
+	var r responses.Topic
+	err := c.httpClient.Do(http.MethodPost, "topic.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// UpdatesFriends returns the recent activity of the authenticated user's
+// friends. The kind of update and filter are optional, and default to all
+// updates from all friends, while maxUpdates is omitted when zero.
+// https://www.goodreads.com/api/index#updates.friends
+func (c *Client) UpdatesFriends(update UpdateKind, filter UpdateFilter, maxUpdates int) ([]responses.Update, error) {
+	v := c.defaultValues()
+	if update != "" {
+		v.Set("update", string(update))
+	}
+	if filter != "" {
+		v.Set("update_filter", string(filter))
+	}
+	if maxUpdates > 0 {
+		v.Set("max_updates", strconv.Itoa(maxUpdates))
+	}
+
+	var r struct {
+		Updates []responses.Update `xml:"updates>update"`
+	}
+	err := c.httpClient.Get("updates/friends.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Updates, nil
+}
+
+// UserCompare compares the books of the authenticated user with those of another user,
+// returning the books they have in common along with each user's rating of them.
+// See responses.CompareReviews for building a comparison without this endpoint.
+// https://www.goodreads.com/api/index#user.compare
+func (c *Client) UserCompare(userID responses.UserID) (*responses.Comparison, error) {
+	var r struct {
+		Comparison responses.Comparison `xml:"compare"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("user/compare/%s.xml", userID), xml.Unmarshal, c.defaultValues(), &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Comparison, nil
+}
+
+// UserFollowers returns a page of the users following a given user.
+// https://www.goodreads.com/api/index#user.followers
+func (c *Client) UserFollowers(userID responses.UserID, page int) ([]responses.User, error) {
+	v := c.defaultValues()
+	if page > 0 {
+		v.Set("page", strconv.Itoa(page))
+	}
+
+	var r struct {
+		Followers []responses.User `xml:"followers>user"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("user/%s/followers.xml", userID), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Followers, nil
+}
+
+// UserFollowing returns a page of the users that a given user is following.
+// https://www.goodreads.com/api/index#user.following
+func (c *Client) UserFollowing(userID responses.UserID, page int) ([]responses.User, error) {
+	v := c.defaultValues()
+	if page > 0 {
+		v.Set("page", strconv.Itoa(page))
+	}
+
+	var r struct {
+		Following []responses.User `xml:"following>user"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("user/%s/following.xml", userID), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Following, nil
+}
+
+// UserStatusCreate posts a progress update for a book on behalf of the
+// authenticated user. Progress is given as either a page or a percentage,
+// and is omitted when zero.
+// https://www.goodreads.com/api/index#user_status.create
+func (c *Client) UserStatusCreate(bookID responses.BookID, page, percent int, body string) (*responses.UserStatus, error) {
+	v := c.defaultValues()
+	v.Set("user_status[book_id]", string(bookID))
+	if page > 0 {
+		v.Set("user_status[page]", strconv.Itoa(page))
+	}
+	if percent > 0 {
+		v.Set("user_status[percent]", strconv.Itoa(percent))
+	}
+	if body != "" {
+		v.Set("user_status[body]", body)
+	}
+
+	var r responses.UserStatus
+	err := c.httpClient.Do(http.MethodPost, "user_status.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// UserStatusShow returns the details of a user's progress update.
+// https://www.goodreads.com/api/index#user_status.show
+func (c *Client) UserStatusShow(id string) (*responses.UserStatus, error) {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+
+	var r struct {
+		UserStatus responses.UserStatus `xml:"user_status"`
+	}
+	err := c.httpClient.Get(fmt.Sprintf("user_status/show/%s", id), xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r.UserStatus, nil
+}
+
+// UserStatusDestroy deletes a progress update of the authenticated user.
+// https://www.goodreads.com/api/index#user_status.destroy
+func (c *Client) UserStatusDestroy(id string) error {
+	v := c.defaultValues()
+	v.Set("format", "xml")
+	return c.httpClient.Do(http.MethodDelete, fmt.Sprintf("user_status/destroy/%s", id), xml.Unmarshal, v, nil)
+}
+
+// UserStatusIndex returns the most recent progress updates from across Goodreads.
+// https://www.goodreads.com/api/index#user_status.index
+func (c *Client) UserStatusIndex() ([]responses.UserStatus, error) {
+	var r struct {
+		UserStatuses []responses.UserStatus `xml:"user_statuses>user_status"`
+	}
+	err := c.httpClient.Get("user_status/index.xml", xml.Unmarshal, c.defaultValues(), &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.UserStatuses, nil
+}
+
 // UserShow returns the public information about a given Goodreads user.
 // https://www.goodreads.com/api/index#user.show
 func (c *Client) UserShow(id string) (*responses.User, error) {
@@ -159,6 +1061,46 @@ func (c *Client) UserShow(id string) (*responses.User, error) {
 	return &r.User, nil
 }
 
+func (c *Client) searchWorks(query string, page int, field SearchField) ([]work.Work, error) {
+	v := c.defaultValues()
+	v.Set("q", query)
+	v.Set("search[field]", string(field))
+	if page != 0 {
+		v.Set("page", strconv.Itoa(page))
+	}
+
+	var r struct {
+		Works []work.Work `xml:"search>results>work"`
+	}
+
+	err := c.httpClient.Get("search/index.xml", xml.Unmarshal, v, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Works, nil
+}
+
+func (c *Client) reviewValues(body string, rating *int, readAt, shelf string) (url.Values, error) {
+	v := c.defaultValues()
+	if rating != nil {
+		if *rating < 0 || *rating > 5 {
+			return nil, fmt.Errorf("invalid rating %d: must be between 0 and 5", *rating)
+		}
+		v.Set("review[rating]", strconv.Itoa(*rating))
+	}
+	if body != "" {
+		v.Set("review[review]", body)
+	}
+	if readAt != "" {
+		v.Set("review[read_at]", readAt)
+	}
+	if shelf != "" {
+		v.Set("shelf", shelf)
+	}
+	return v, nil
+}
+
 func (c *Client) defaultValues() url.Values {
 	v := url.Values{}
 	v.Set("key", c.APIKey)
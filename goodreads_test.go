@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"github.com/KyleBanks/goodreads/responses"
 	"github.com/KyleBanks/goodreads/responses/work"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -20,94 +22,1133 @@ func TestNewClient(t *testing.T) {
 	assert.Equal(t, defaultAPIClient, c.httpClient)
 }
 
+func TestNewAuthenticatedClient(t *testing.T) {
+	h := &http.Client{}
+	c := NewAuthenticatedClient("api-key", h)
+	assert.NotNil(t, c)
+	assert.Equal(t, "api-key", c.APIKey)
+	assert.Equal(t, &httpClient{Client: h, APIRoot: defaultAPIRoot}, c.httpClient)
+}
+
+func TestClient_AuthUser(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/api/auth_user?key=%s", testAPIKey),
+		response: `<response>
+			<user id="user-id">
+				<name>User Name</name>
+				<link><![CDATA[https://www.goodreads.com/user/show/user-id]]></link>
+			</user>
+		</response>`,
+	})
+	defer done()
+
+	u, err := c.AuthUser()
+	assert.Nil(t, err)
+	assert.Equal(t, responses.User{
+		ID:   "user-id",
+		Name: "User Name",
+		Link: "https://www.goodreads.com/user/show/user-id",
+	}, *u)
+}
+
+func TestClient_AuthUserShow(t *testing.T) {
+	c, done := newRoutedTestClient(t, map[string]string{
+		"/api/auth_user":         `<response><user id="user-id"><name>User Name</name></user></response>`,
+		"/user/show/user-id.xml": `<response><user><id>user-id</id><name>User Name</name><friends_count>3</friends_count></user></response>`,
+	})
+	defer done()
+
+	u, err := c.AuthUserShow()
+	assert.Nil(t, err)
+	assert.Equal(t, responses.User{
+		ID:           "user-id",
+		Name:         "User Name",
+		FriendsCount: 3,
+	}, *u)
+}
+
+func TestClient_AuthUserShelves(t *testing.T) {
+	c, done := newRoutedTestClient(t, map[string]string{
+		"/api/auth_user": `<response><user id="user-id"><name>User Name</name></user></response>`,
+		"/shelf/list.xml": `<response><shelves>
+			<user_shelf><id>shelf1</id><name>read</name></user_shelf>
+		</shelves></response>`,
+	})
+	defer done()
+
+	s, err := c.AuthUserShelves()
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.UserShelf{
+		{ID: "shelf1", Name: "read"},
+	}, s)
+}
+
+func TestClient_AuthorByName(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/api/author_url/Haruki%%20Murakami?key=%s", testAPIKey),
+		response: `<response>
+			<author id="3354">
+				<name><![CDATA[Haruki Murakami]]></name>
+				<link>https://www.goodreads.com/author/show/3354.Haruki_Murakami</link>
+			</author>
+		</response>`,
+	})
+	defer done()
+
+	a, err := c.AuthorByName("Haruki Murakami")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Author{
+		ID:   "3354",
+		Name: "Haruki Murakami",
+		Link: "https://www.goodreads.com/author/show/3354.Haruki_Murakami",
+	}, *a)
+
+	t.Run("with unknown author", func(t *testing.T) {
+		c, done := newTestClient(t, decodeTestCase{
+			expectURL: fmt.Sprintf("/api/author_url/nobody?key=%s", testAPIKey),
+			response:  `<response></response>`,
+		})
+		defer done()
+
+		a, err := c.AuthorByName("nobody")
+		assert.NotNil(t, err)
+		assert.Nil(t, a)
+	})
+}
+
+func TestClient_AuthorShowByName(t *testing.T) {
+	c, done := newRoutedTestClient(t, map[string]string{
+		"/api/author_url/Haruki Murakami": `<response><author id="3354"><name>Haruki Murakami</name></author></response>`,
+		"/author/show/3354":               `<response><author><id>3354</id><name>Haruki Murakami</name><works_count>100</works_count></author></response>`,
+	})
+	defer done()
+
+	a, err := c.AuthorShowByName("Haruki Murakami")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Author{
+		ID:         "3354",
+		Name:       "Haruki Murakami",
+		WorksCount: 100,
+	}, *a)
+}
+
 func TestClient_AuthorBooks(t *testing.T) {
 	c, done := newTestClient(t, decodeTestCase{
-		expectURL: fmt.Sprintf("/author/list/12345?key=%s&page=1", testAPIKey),
-		response:  `<response><author><id>AuthorID</id><name>AuthorName</name></author></response>`,
+		expectURL: fmt.Sprintf("/author/list/12345?key=%s&page=1", testAPIKey),
+		response:  `<response><author><id>AuthorID</id><name>AuthorName</name></author></response>`,
+	})
+	defer done()
+
+	a, err := c.AuthorBooks("12345", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Author{
+		ID:   "AuthorID",
+		Name: "AuthorName",
+	}, *a)
+}
+
+func TestClient_AuthorShow(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/author/show/12345?key=%s", testAPIKey),
+		response:  `<response><author><id>AuthorID</id><name>AuthorName</name></author></response>`,
+	})
+	defer done()
+
+	a, err := c.AuthorShow("12345")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Author{
+		ID:   "AuthorID",
+		Name: "AuthorName",
+	}, *a)
+}
+
+func TestClient_CommentList(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/comment/index.xml?id=status-id&key=%s&page=2&type=user_status", testAPIKey),
+		response: `<response>
+			<comments start="21" end="22" total="22">
+				<comment>
+					<id>comment1</id>
+					<body>Keep going!</body>
+					<user><id>user-id</id><name>User Name</name></user>
+					<created_at>Tue Aug 06 10:00:00 -0700 2019</created_at>
+					<updated_at>Wed Aug 07 10:00:00 -0700 2019</updated_at>
+				</comment>
+				<comment><id>comment2</id><body>Almost there.</body></comment>
+			</comments>
+		</response>`,
+	})
+	defer done()
+
+	comments, err := c.CommentList(CommentResourceUserStatus, "status-id", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.Comment{
+		{
+			ID:        "comment1",
+			Body:      "Keep going!",
+			User:      responses.User{ID: "user-id", Name: "User Name"},
+			CreatedAt: "Tue Aug 06 10:00:00 -0700 2019",
+			UpdatedAt: "Wed Aug 07 10:00:00 -0700 2019",
+		},
+		{ID: "comment2", Body: "Almost there."},
+	}, comments)
+}
+
+func TestClient_CommentCreate(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPost,
+		expectURL:    "/comment.xml",
+		expectBody:   fmt.Sprintf("comment%%5Bbody%%5D=Great+review&id=review-id&key=%s&type=review", testAPIKey),
+		response:     `<comment><id>comment-id</id><body>Great review</body></comment>`,
+	})
+	defer done()
+
+	comment, err := c.CommentCreate(CommentResourceReview, "review-id", "Great review")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Comment{
+		ID:   "comment-id",
+		Body: "Great review",
+	}, *comment)
+}
+
+func TestClient_EventsList(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/event/index.xml?key=%s&lat=43.6532&lng=-79.3832&search%%5Bcountry_code%%5D=CA&search%%5Bpostal_code%%5D=M5H", testAPIKey),
+		response: `<response>
+			<events>
+				<event>
+					<id>event1</id>
+					<title>Book Signing</title>
+					<venue>Local Bookstore</venue>
+					<address>123 Main St</address>
+					<city>Toronto</city>
+					<country_code>CA</country_code>
+					<resource_type>Book</resource_type>
+					<resource_id>book-id</resource_id>
+					<start_at type="datetime">2019-08-06T19:00:00-04:00</start_at>
+					<end_at type="datetime">2019-08-06T21:00:00-04:00</end_at>
+				</event>
+				<event>
+					<id>event2</id>
+					<start_at type="datetime">2019-08-07T10:00:00Z</start_at>
+					<end_at type="datetime" nil="true"/>
+				</event>
+			</events>
+		</response>`,
+	})
+	defer done()
+
+	e, err := c.EventsList(43.6532, -79.3832, "CA", "M5H")
+	assert.Nil(t, err)
+	assert.Len(t, e, 2)
+	assert.Equal(t, "event1", e[0].ID)
+	assert.Equal(t, "Book Signing", e[0].Title)
+	assert.Equal(t, "Local Bookstore", e[0].Venue)
+	assert.Equal(t, "123 Main St", e[0].Address)
+	assert.Equal(t, "Toronto", e[0].City)
+	assert.Equal(t, "CA", e[0].CountryCode)
+	assert.Equal(t, "Book", e[0].ResourceType)
+	assert.Equal(t, "book-id", e[0].ResourceID)
+	assert.True(t, e[0].StartAt.Equal(time.Date(2019, 8, 6, 23, 0, 0, 0, time.UTC)))
+	assert.True(t, e[0].EndAt.Equal(time.Date(2019, 8, 7, 1, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "event2", e[1].ID)
+	assert.True(t, e[1].StartAt.Equal(time.Date(2019, 8, 7, 10, 0, 0, 0, time.UTC)))
+	assert.True(t, e[1].EndAt.IsZero())
+
+	t.Run("with other time formats", func(t *testing.T) {
+		c, done := newTestClient(t, decodeTestCase{
+			expectURL: fmt.Sprintf("/event/index.xml?key=%s", testAPIKey),
+			response: `<response><events>
+				<event><id>1</id><start_at>tomorrow</start_at><end_at>Sat Sep 14 21:00:00 -0700 2019</end_at></event>
+				<event><id>2</id><start_at>2019-09-14</start_at></event>
+			</events></response>`,
+		})
+		defer done()
+
+		e, err := c.EventsList(0, 0, "", "")
+		assert.Nil(t, err)
+		assert.Len(t, e, 2)
+		assert.True(t, e[0].StartAt.IsZero())
+		assert.Equal(t, time.Date(2019, 9, 14, 21, 0, 0, 0, time.FixedZone("", -7*60*60)).Unix(), e[0].EndAt.Unix())
+		assert.Equal(t, time.Date(2019, 9, 14, 0, 0, 0, 0, time.UTC), e[1].StartAt)
+	})
+}
+
+func TestClient_FollowAuthor(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPost,
+		expectURL:    "/author_followings",
+		expectBody:   fmt.Sprintf("format=xml&id=author-id&key=%s", testAPIKey),
+		response: `<author_following>
+			<id>following-id</id>
+			<author><id>author-id</id><name>Author Name</name></author>
+			<user><id>user-id</id></user>
+		</author_following>`,
+	})
+	defer done()
+
+	f, err := c.FollowAuthor("author-id")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.AuthorFollowing{
+		ID:     "following-id",
+		Author: responses.Author{ID: "author-id", Name: "Author Name"},
+		User:   responses.User{ID: "user-id"},
+	}, *f)
+}
+
+func TestClient_UnfollowAuthor(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodDelete,
+		expectURL:    "/author_followings/following-id",
+		expectBody:   fmt.Sprintf("format=xml&key=%s", testAPIKey),
+	})
+	defer done()
+
+	err := c.UnfollowAuthor("following-id")
+	assert.Nil(t, err)
+}
+
+func TestClient_AuthorFollowing(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/author_followings/following-id?format=xml&key=%s", testAPIKey),
+		response: `<author_following>
+			<id>following-id</id>
+			<created_at>Tue Aug 06 10:00:00 -0700 2019</created_at>
+			<author><id>author-id</id></author>
+		</author_following>`,
+	})
+	defer done()
+
+	f, err := c.AuthorFollowing("following-id")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.AuthorFollowing{
+		ID:        "following-id",
+		CreatedAt: "Tue Aug 06 10:00:00 -0700 2019",
+		Author:    responses.Author{ID: "author-id"},
+	}, *f)
+}
+
+func TestClient_FanshipCreate(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPost,
+		expectURL:    "/fanships.xml",
+		expectBody:   fmt.Sprintf("fanship%%5Bauthor_id%%5D=author-id&key=%s", testAPIKey),
+		response: `<fanship>
+			<id>fanship-id</id>
+			<author><id>author-id</id></author>
+		</fanship>`,
+	})
+	defer done()
+
+	f, err := c.FanshipCreate("author-id")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Fanship{
+		ID:     "fanship-id",
+		Author: responses.Author{ID: "author-id"},
+	}, *f)
+}
+
+func TestClient_FanshipDestroy(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodDelete,
+		expectURL:    "/fanships/destroy.xml",
+		expectBody:   fmt.Sprintf("fanship%%5Bauthor_id%%5D=author-id&key=%s", testAPIKey),
+	})
+	defer done()
+
+	err := c.FanshipDestroy("author-id")
+	assert.Nil(t, err)
+}
+
+func TestClient_FanshipShow(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/fanships/show/fanship-id.xml?key=%s", testAPIKey),
+		response: `<fanship>
+			<id>fanship-id</id>
+			<user><id>user-id</id></user>
+		</fanship>`,
+	})
+	defer done()
+
+	f, err := c.FanshipShow("fanship-id")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Fanship{
+		ID:   "fanship-id",
+		User: responses.User{ID: "user-id"},
+	}, *f)
+}
+
+func TestClient_BookReviewCounts(t *testing.T) {
+	isbn := "9781400078776"
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/book/review_counts.json?isbns=%s&key=%s", isbn, testAPIKey),
+		response: `{
+			"books": [{
+				"average_rating": "3.82",
+				"id": 15,
+				"isbn": "1400078776",
+				"isbn13": "9781400078776",
+				"ratings_count": 1,
+				"reviews_count": 2,
+				"text_reviews_count": 3,
+				"work_ratings_count": 4,
+				"work_reviews_count": 5,
+				"work_text_reviews_count": 6
+			}]
+		}`,
+	})
+	defer done()
+
+	counts, err := c.BookReviewCounts([]string{isbn})
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.ReviewCounts{
+		{
+			ID:                   15,
+			ISBN:                 "1400078776",
+			ISBN13:               "9781400078776",
+			RatingsCount:         1,
+			ReviewsCount:         2,
+			TextReviewsCount:     3,
+			WorkRatingsCount:     4,
+			WorkReviewsCount:     5,
+			WorkTextReviewsCount: 6,
+			AverageRating:        "3.82",
+		},
+	}, counts)
+}
+
+func TestClient_BookShow(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/book/show/11297.xml?key=%s", testAPIKey),
+		response: `<response>
+			<book>
+				<id>11297</id>
+				<title>Norwegian Wood</title>
+				<work>
+					<id>4835472</id>
+					<rating_dist>5:4|4:3|3:2|2:0|1:1|total:10</rating_dist>
+				</work>
+				<popular_shelves>
+					<shelf name="to-read" count="100"/>
+					<shelf name="fiction" count="40"/>
+				</popular_shelves>
+			</book>
+		</response>`,
+	})
+	defer done()
+
+	b, err := c.BookShow("11297")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.AuthorBook{
+		ID:         "11297",
+		Title:      "Norwegian Wood",
+		RatingDist: responses.RatingDistribution{Counts: [5]int{1, 0, 2, 3, 4}, Total: 10},
+		PopularShelves: []responses.PopularShelf{
+			{Name: "to-read", Count: 100},
+			{Name: "fiction", Count: 40},
+		},
+	}, *b)
+}
+
+func TestClient_FriendsList(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/friend/user/user-id?format=xml&key=%s&page=2&sort=last_online", testAPIKey),
+		response: `<response>
+			<friends start="31" end="32" total="32">
+				<user><id>friend1</id><name>Friend 1</name><friends_count>10</friends_count></user>
+				<user><id>friend2</id><name>Friend 2</name><reviews_count>5</reviews_count></user>
+			</friends>
+		</response>`,
+	})
+	defer done()
+
+	f, err := c.FriendsList("user-id", 2, FriendsSortLastOnline)
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.User{
+		{ID: "friend1", Name: "Friend 1", FriendsCount: 10},
+		{ID: "friend2", Name: "Friend 2", ReviewCount: 5},
+	}, f)
+}
+
+func TestClient_FriendRequests(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/friend/requests.xml?key=%s&page=1", testAPIKey),
+		response: `<response>
+			<requests>
+				<friend_request>
+					<id>request-id</id>
+					<created_at>Tue Aug 06 10:00:00 -0700 2019</created_at>
+					<message>Hi!</message>
+					<from_user><id>user-id</id><name>User Name</name></from_user>
+				</friend_request>
+			</requests>
+		</response>`,
+	})
+	defer done()
+
+	r, err := c.FriendRequests(1)
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.FriendRequest{
+		{
+			ID:        "request-id",
+			CreatedAt: "Tue Aug 06 10:00:00 -0700 2019",
+			Message:   "Hi!",
+			FromUser:  responses.User{ID: "user-id", Name: "User Name"},
+		},
+	}, r)
+}
+
+func TestClient_FriendConfirmRequest(t *testing.T) {
+	testCases := []struct {
+		Accept   bool
+		Response string
+	}{
+		{true, "Y"},
+		{false, "N"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("with accept %t", tc.Accept), func(t *testing.T) {
+			c, done := newTestClient(t, decodeTestCase{
+				expectMethod: http.MethodPost,
+				expectURL:    "/friend/confirm_request.xml",
+				expectBody:   fmt.Sprintf("id=request-id&key=%s&response=%s", testAPIKey, tc.Response),
+			})
+			defer done()
+
+			err := c.FriendConfirmRequest("request-id", tc.Accept)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestClient_GroupList(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/group/list/user-id.xml?key=%s&sort=members", testAPIKey),
+		response: `<response>
+			<groups>
+				<list start="1" end="2" total="2">
+					<group><id>group1</id><title>Group 1</title><users_count>100</users_count></group>
+					<group><id>group2</id><title>Group 2</title><users_count>50</users_count></group>
+				</list>
+			</groups>
+		</response>`,
+	})
+	defer done()
+
+	g, err := c.GroupList("user-id", GroupSortMembers)
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.Group{
+		{ID: "group1", Title: "Group 1", UsersCount: 100},
+		{ID: "group2", Title: "Group 2", UsersCount: 50},
+	}, g)
+}
+
+func TestClient_GroupSearch(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/group/search.xml?key=%s&page=2&q=sci-fi", testAPIKey),
+		response: `<response>
+			<groups>
+				<list>
+					<group><id>group1</id><title>Sci-Fi Readers</title><access>public</access></group>
+				</list>
+			</groups>
+		</response>`,
+	})
+	defer done()
+
+	g, err := c.GroupSearch("sci-fi", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.Group{
+		{ID: "group1", Title: "Sci-Fi Readers", Access: "public"},
+	}, g)
+}
+
+func TestClient_GroupShow(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/group/show/group-id.xml?key=%s", testAPIKey),
+		response: `<response>
+			<group>
+				<id>group-id</id>
+				<title>Book Club</title>
+				<members_count>25</members_count>
+				<accepting_new_members_flag>true</accepting_new_members_flag>
+				<moderators>
+					<group_user><user><id>user-id</id></user><title>Moderator</title></group_user>
+				</moderators>
+				<folders>
+					<folder><id>folder-id</id><name>General</name><items_count>12</items_count></folder>
+				</folders>
+			</group>
+		</response>`,
+	})
+	defer done()
+
+	g, err := c.GroupShow("group-id")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Group{
+		ID:                  "group-id",
+		Title:               "Book Club",
+		MembersCount:        25,
+		AcceptingNewMembers: true,
+		Moderators: []responses.GroupMember{
+			{User: responses.User{ID: "user-id"}, Title: "Moderator"},
+		},
+		Folders: []responses.GroupFolder{
+			{ID: "folder-id", Name: "General", ItemsCount: 12},
+		},
+	}, *g)
+}
+
+func TestClient_GroupMembers(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/group/members/group-id.xml?key=%s&page=1&q=kyle&sort=num_comments", testAPIKey),
+		response: `<response>
+			<group_users>
+				<group_user>
+					<user><id>user-id</id><name>Kyle</name></user>
+					<comments_count>42</comments_count>
+					<created_at>Tue Aug 06 10:00:00 -0700 2019</created_at>
+				</group_user>
+			</group_users>
+		</response>`,
+	})
+	defer done()
+
+	m, err := c.GroupMembers("group-id", 1, GroupMembersSortNumComments, "kyle")
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.GroupMember{
+		{
+			User:          responses.User{ID: "user-id", Name: "Kyle"},
+			CommentsCount: 42,
+			CreatedAt:     "Tue Aug 06 10:00:00 -0700 2019",
+		},
+	}, m)
+}
+
+func TestClient_GroupJoin(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPost,
+		expectURL:    "/group/join",
+		expectBody:   fmt.Sprintf("format=xml&id=group-id&key=%s", testAPIKey),
+	})
+	defer done()
+
+	err := c.GroupJoin("group-id")
+	assert.Nil(t, err)
+}
+
+func TestClient_ListsForBook(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/list/book/book-id.xml?key=%s", testAPIKey),
+		response: `<response>
+			<lists>
+				<list>
+					<id>list1</id>
+					<title>Best Books Ever</title>
+					<books_count>1000</books_count>
+					<voters_count>5000</voters_count>
+					<rank>12</rank>
+					<votes>3400</votes>
+				</list>
+				<list><id>list2</id><title>Books to Read Twice</title><rank>3</rank></list>
+			</lists>
+		</response>`,
+	})
+	defer done()
+
+	l, err := c.ListsForBook("book-id")
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.List{
+		{
+			ID:          "list1",
+			Title:       "Best Books Ever",
+			BooksCount:  1000,
+			VotersCount: 5000,
+			Rank:        12,
+			Votes:       3400,
+		},
+		{ID: "list2", Title: "Books to Read Twice", Rank: 3},
+	}, l)
+}
+
+func TestClient_Notifications(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/notifications.xml?key=%s&page=1", testAPIKey),
+		response: `<response>
+			<notifications>
+				<notification>
+					<actors><user><id>user-id</id><name>User Name</name></user></actors>
+					<new>true</new>
+					<created_at>2019-08-06T10:00:00-07:00</created_at>
+					<url>https://www.goodreads.com/review/show/review-id</url>
+					<resource_type>Comment</resource_type>
+					<group_resource_type>Review</group_resource_type>
+					<body>
+						<html><![CDATA[<a href="#">User Name</a> commented on your review.]]></html>
+						<text>User Name commented on your review.</text>
+					</body>
+				</notification>
+			</notifications>
+		</response>`,
+	})
+	defer done()
+
+	n, err := c.Notifications(1)
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.Notification{
+		{
+			Actors:            []responses.User{{ID: "user-id", Name: "User Name"}},
+			New:               true,
+			CreatedAt:         "2019-08-06T10:00:00-07:00",
+			URL:               "https://www.goodreads.com/review/show/review-id",
+			ResourceType:      "Comment",
+			GroupResourceType: "Review",
+			Text:              "User Name commented on your review.",
+			HTML:              `<a href="#">User Name</a> commented on your review.`,
+		},
+	}, n)
+}
+
+func TestClient_OwnedBooksList(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/owned_books/user/user-id.xml?key=%s&page=1", testAPIKey),
+		response: `<response>
+			<owned_books>
+				<owned_book>
+					<id>owned1</id>
+					<book><id>book1</id></book>
+					<condition_code>20</condition_code>
+					<condition>like new</condition>
+				</owned_book>
+				<owned_book><id>owned2</id><book><id>book2</id></book></owned_book>
+			</owned_books>
+		</response>`,
+	})
+	defer done()
+
+	o, err := c.OwnedBooksList("user-id", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.OwnedBook{
+		{
+			ID:            "owned1",
+			Book:          responses.AuthorBook{ID: "book1"},
+			ConditionCode: 20,
+			Condition:     "like new",
+		},
+		{ID: "owned2", Book: responses.AuthorBook{ID: "book2"}},
+	}, o)
+}
+
+func TestClient_OwnedBookShow(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/owned_books/show/owned-id.xml?key=%s", testAPIKey),
+		response: `<response>
+			<owned_book>
+				<id>owned-id</id>
+				<original_purchase_date>2019-08-06</original_purchase_date>
+				<original_purchase_location>Local Bookstore</original_purchase_location>
+				<current_owner_id>user-id</current_owner_id>
+				<traded_count>1</traded_count>
+				<available_for_swap>true</available_for_swap>
+			</owned_book>
+		</response>`,
+	})
+	defer done()
+
+	o, err := c.OwnedBookShow("owned-id")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.OwnedBook{
+		ID:                       "owned-id",
+		OriginalPurchaseDate:     "2019-08-06",
+		OriginalPurchaseLocation: "Local Bookstore",
+		CurrentOwnerID:           "user-id",
+		TradedCount:              1,
+		AvailableForSwap:         true,
+	}, *o)
+}
+
+func TestClient_OwnedBookCreate(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPost,
+		expectURL:    "/owned_books.xml",
+		expectBody:   fmt.Sprintf("key=%s&owned_book%%5Bbook_id%%5D=book-id&owned_book%%5Bcondition_code%%5D=10&owned_book%%5Boriginal_purchase_date%%5D=2019-08-06&owned_book%%5Boriginal_purchase_location%%5D=Local+Bookstore", testAPIKey),
+		response:     `<owned_book><id>owned-id</id><condition_code>10</condition_code></owned_book>`,
+	})
+	defer done()
+
+	o, err := c.OwnedBookCreate("book-id", OwnedBookOptions{
+		Condition:        responses.ConditionBrandNew,
+		PurchaseDate:     "2019-08-06",
+		PurchaseLocation: "Local Bookstore",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, responses.OwnedBook{
+		ID:            "owned-id",
+		ConditionCode: 10,
+	}, *o)
+}
+
+func TestClient_OwnedBookUpdate(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPut,
+		expectURL:    "/owned_books/update",
+		expectBody:   fmt.Sprintf("format=xml&id=owned-id&key=%s&owned_book%%5Bcondition_code%%5D=50&owned_book%%5Bunique_code%%5D=BC-123", testAPIKey),
+		response:     `<owned_book><id>owned-id</id><condition_code>50</condition_code><unique_code>BC-123</unique_code></owned_book>`,
+	})
+	defer done()
+
+	o, err := c.OwnedBookUpdate("owned-id", OwnedBookOptions{
+		Condition:  responses.ConditionAcceptable,
+		UniqueCode: "BC-123",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, responses.OwnedBook{
+		ID:            "owned-id",
+		ConditionCode: 50,
+		UniqueCode:    "BC-123",
+	}, *o)
+}
+
+func TestClient_OwnedBookDestroy(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodDelete,
+		expectURL:    "/owned_books/destroy/owned-id",
+		expectBody:   fmt.Sprintf("format=xml&key=%s", testAPIKey),
+	})
+	defer done()
+
+	err := c.OwnedBookDestroy("owned-id")
+	assert.Nil(t, err)
+}
+
+func TestClient_QuoteCreate(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPost,
+		expectURL:    "/quotes.xml",
+		expectBody:   fmt.Sprintf("isbn=9781400078776&key=%s&quote%%5Bauthor_id%%5D=3354&quote%%5Bauthor_name%%5D=Haruki+Murakami&quote%%5Bbody%%5D=Pain+is+inevitable.&quote%%5Btags%%5D=pain%%2Crunning", testAPIKey),
+		response: `<quote>
+			<id>quote-id</id>
+			<body>Pain is inevitable.</body>
+			<author_name>Haruki Murakami</author_name>
+			<author_id>3354</author_id>
+		</quote>`,
+	})
+	defer done()
+
+	q, err := c.QuoteCreate("Haruki Murakami", "3354", "", "Pain is inevitable.", []string{"pain", "running"}, "9781400078776")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Quote{
+		ID:         "quote-id",
+		Body:       "Pain is inevitable.",
+		AuthorName: "Haruki Murakami",
+		AuthorID:   "3354",
+	}, *q)
+}
+
+func TestClient_RatingCreate(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPost,
+		expectURL:    "/rating",
+		expectBody:   fmt.Sprintf("book_id=book-id&format=xml&key=%s&rating=3", testAPIKey),
+	})
+	defer done()
+
+	err := c.RatingCreate("book-id", 3)
+	assert.Nil(t, err)
+
+	t.Run("with invalid rating", func(t *testing.T) {
+		assert.NotNil(t, c.RatingCreate("book-id", 10))
+	})
+}
+
+func TestClient_RatingDestroy(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodDelete,
+		expectURL:    "/rating",
+		expectBody:   fmt.Sprintf("book_id=book-id&format=xml&key=%s", testAPIKey),
+	})
+	defer done()
+
+	err := c.RatingDestroy("book-id")
+	assert.Nil(t, err)
+}
+
+func TestClient_ReadStatusShow(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/read_statuses/status-id?format=xml&key=%s", testAPIKey),
+		response: `<response>
+			<read_status>
+				<id>status-id</id>
+				<review_id>review-id</review_id>
+				<user_id>user-id</user_id>
+				<old_status>to-read</old_status>
+				<status>currently-reading</status>
+				<user><id>user-id</id></user>
+				<review><id>review-id</id></review>
+			</read_status>
+		</response>`,
+	})
+	defer done()
+
+	s, err := c.ReadStatusShow("status-id")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.ReadStatus{
+		ID:        "status-id",
+		ReviewID:  "review-id",
+		UserID:    "user-id",
+		OldStatus: "to-read",
+		Status:    "currently-reading",
+		User:      responses.User{ID: "user-id"},
+		Review:    responses.Review{ID: "review-id"},
+	}, *s)
+}
+
+func TestClient_RecommendationShow(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/recommendations/recommendation-id?format=xml&key=%s", testAPIKey),
+		response: `<response>
+			<recommendation>
+				<id>recommendation-id</id>
+				<message>You'll love this.</message>
+				<from_user><id>user1</id></from_user>
+				<to_user><id>user2</id></to_user>
+				<book><id>book-id</id><title>Book Title</title></book>
+			</recommendation>
+		</response>`,
+	})
+	defer done()
+
+	r, err := c.RecommendationShow("recommendation-id")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Recommendation{
+		ID:       "recommendation-id",
+		Message:  "You'll love this.",
+		FromUser: responses.User{ID: "user1"},
+		ToUser:   responses.User{ID: "user2"},
+		Book:     responses.AuthorBook{ID: "book-id", Title: "Book Title"},
+	}, *r)
+}
+
+func TestClient_ReviewCreate(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPost,
+		expectURL:    "/review.xml",
+		expectBody:   fmt.Sprintf("book_id=book-id&key=%s&review%%5Brating%%5D=4&review%%5Bread_at%%5D=2019-08-06&review%%5Breview%%5D=Great+read&shelf=read", testAPIKey),
+		response:     `<review><id>review-id</id><rating>4</rating><body>Great read</body></review>`,
+	})
+	defer done()
+
+	r, err := c.ReviewCreate("book-id", "Great read", 4, "2019-08-06", "read")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Review{
+		ID:     "review-id",
+		Rating: 4,
+		Body:   "Great read",
+	}, *r)
+
+	t.Run("with invalid rating", func(t *testing.T) {
+		r, err := c.ReviewCreate("book-id", "", 6, "", "")
+		assert.NotNil(t, err)
+		assert.Nil(t, r)
+	})
+}
+
+func TestClient_ReviewEdit(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPut,
+		expectURL:    "/review/review-id.xml",
+		expectBody:   fmt.Sprintf("finished=true&key=%s&review%%5Brating%%5D=5", testAPIKey),
+		response:     `<review><id>review-id</id><rating>5</rating></review>`,
+	})
+	defer done()
+
+	r, err := c.ReviewEdit("review-id", "", Int(5), "", "", true)
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Review{
+		ID:     "review-id",
+		Rating: 5,
+	}, *r)
+
+	t.Run("with invalid rating", func(t *testing.T) {
+		r, err := c.ReviewEdit("review-id", "", Int(-1), "", "", false)
+		assert.NotNil(t, err)
+		assert.Nil(t, r)
+	})
+
+	t.Run("without rating", func(t *testing.T) {
+		c, done := newTestClient(t, decodeTestCase{
+			expectMethod: http.MethodPut,
+			expectURL:    "/review/review-id.xml",
+			expectBody:   fmt.Sprintf("key=%s&review%%5Breview%%5D=Updated", testAPIKey),
+			response:     `<review><id>review-id</id><rating>3</rating><body>Updated</body></review>`,
+		})
+		defer done()
+
+		r, err := c.ReviewEdit("review-id", "Updated", nil, "", "", false)
+		assert.Nil(t, err)
+		assert.Equal(t, responses.Review{
+			ID:     "review-id",
+			Rating: 3,
+			Body:   "Updated",
+		}, *r)
+	})
+}
+
+func TestClient_ReviewDestroy(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodDelete,
+		expectURL:    "/review/destroy/book-id",
+		expectBody:   fmt.Sprintf("format=xml&key=%s", testAPIKey),
+	})
+	defer done()
+
+	err := c.ReviewDestroy("book-id")
+	assert.Nil(t, err)
+}
+
+func TestClient_ReviewList(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/review/list/user-id.xml?key=%s&order=d&page=1&per_page=200&search=search&shelf=read&sort=date_read&v=2", testAPIKey),
+		response: `<response>
+			<reviews>
+				<review>
+					<id>review1</id>
+					<rating>1</rating>
+					<votes>2</votes>
+					<spoiler_flag>false</spoiler_flag>
+					<spoilers_state>none</spoilers_state>
+					<shelves>
+						<shelf name="read" exclusive="true" id="shelf1" sortable="false" />
+						<shelf name="favorites" exclusive="false" id="shelf2" sortable="true" />
+					</shelves>
+					<recommended_for>Everyone</recommended_for>
+					<recommended_by>A friend</recommended_by>
+					<comments_count>3</comments_count>
+					<url><![CDATA[https://www.goodreads.com/review/show/review1]]></url>
+					<link><![CDATA[https://www.goodreads.com/review/show/review1]]></link>
+					<owned>1</owned>
+				</review>
+				<review><id>review2</id><rating>2</rating></review>
+				<review><id>review3</id><rating>3</rating></review>
+			</reviews>
+		</response>`,
 	})
 	defer done()
 
-	a, err := c.AuthorBooks("12345", 1)
+	r, err := c.ReviewList("user-id", "read", "date_read", "search", "d", 1, 200)
 	assert.Nil(t, err)
-	assert.Equal(t, responses.Author{
-		ID:   "AuthorID",
-		Name: "AuthorName",
-	}, *a)
+	assert.Equal(t, []responses.Review{
+		{
+			ID:            "review1",
+			Rating:        1,
+			Votes:         2,
+			SpoilersState: "none",
+			Shelves: []responses.ReviewShelf{
+				{ID: "shelf1", Name: "read", Exclusive: true},
+				{ID: "shelf2", Name: "favorites", Sortable: true},
+			},
+			RecommendedFor: "Everyone",
+			RecommendedBy:  "A friend",
+			CommentsCount:  3,
+			URL:            "https://www.goodreads.com/review/show/review1",
+			Link:           "https://www.goodreads.com/review/show/review1",
+			Owned:          1,
+		},
+		{ID: "review2", Rating: 2},
+		{ID: "review3", Rating: 3},
+	}, r)
 }
 
-func TestClient_AuthorShow(t *testing.T) {
+func TestClient_ReviewShow(t *testing.T) {
 	c, done := newTestClient(t, decodeTestCase{
-		expectURL: fmt.Sprintf("/author/show/12345?key=%s", testAPIKey),
-		response:  `<response><author><id>AuthorID</id><name>AuthorName</name></author></response>`,
+		expectURL: fmt.Sprintf("/review/show.xml?id=review-id&key=%s&page=2", testAPIKey),
+		response: `<response>
+			<review>
+				<id>review-id</id>
+				<user><id>user-id</id><name>User Name</name></user>
+				<rating>4</rating>
+				<votes>12</votes>
+				<spoiler_flag>true</spoiler_flag>
+				<url>https://www.goodreads.com/review/show/review-id</url>
+				<comments start="21" end="22" total="22">
+					<comment>
+						<id>comment1</id>
+						<body>Agreed!</body>
+						<user><id>user2</id><name>User 2</name></user>
+						<created_at>Tue Aug 06 10:00:00 -0700 2019</created_at>
+						<updated_at>Tue Aug 06 10:00:00 -0700 2019</updated_at>
+					</comment>
+					<comment><id>comment2</id><body>Not for me.</body></comment>
+				</comments>
+			</review>
+		</response>`,
 	})
 	defer done()
 
-	a, err := c.AuthorShow("12345")
+	r, err := c.ReviewShow("review-id", 2)
 	assert.Nil(t, err)
-	assert.Equal(t, responses.Author{
-		ID:   "AuthorID",
-		Name: "AuthorName",
-	}, *a)
+	assert.Equal(t, responses.Review{
+		ID:          "review-id",
+		User:        responses.User{ID: "user-id", Name: "User Name"},
+		Rating:      4,
+		Votes:       12,
+		SpoilerFlag: true,
+		URL:         "https://www.goodreads.com/review/show/review-id",
+		Comments: []responses.Comment{
+			{
+				ID:        "comment1",
+				Body:      "Agreed!",
+				User:      responses.User{ID: "user2", Name: "User 2"},
+				CreatedAt: "Tue Aug 06 10:00:00 -0700 2019",
+				UpdatedAt: "Tue Aug 06 10:00:00 -0700 2019",
+			},
+			{ID: "comment2", Body: "Not for me."},
+		},
+	}, *r)
 }
 
-func TestClient_BookReviewCounts(t *testing.T) {
-	isbn := "9781400078776"
+func TestClient_ReviewByUserAndBook(t *testing.T) {
 	c, done := newTestClient(t, decodeTestCase{
-		expectURL: fmt.Sprintf("/book/review_counts.json?isbns=%s&key=%s", isbn, testAPIKey),
-		response: `{
-			"books": [{
-				"average_rating": "3.82",
-				"id": 15,
-				"isbn": "1400078776",
-				"isbn13": "9781400078776",
-				"ratings_count": 1,
-				"reviews_count": 2,
-				"text_reviews_count": 3,
-				"work_ratings_count": 4,
-				"work_reviews_count": 5,
-				"work_text_reviews_count": 6
-			}]
-		}`,
+		expectURL: fmt.Sprintf("/review/show_by_user_and_book.xml?book_id=book-id&key=%s&user_id=user-id", testAPIKey),
+		response: `<response>
+			<review>
+				<id>review-id</id>
+				<user><id>user-id</id></user>
+				<book><id>book-id</id></book>
+				<rating>3</rating>
+			</review>
+		</response>`,
 	})
 	defer done()
 
-	counts, err := c.BookReviewCounts([]string{isbn})
+	r, err := c.ReviewByUserAndBook("user-id", "book-id")
 	assert.Nil(t, err)
-	assert.Equal(t, []responses.ReviewCounts{
-		{
-			ID:                   15,
-			ISBN:                 "1400078776",
-			ISBN13:               "9781400078776",
-			RatingsCount:         1,
-			ReviewsCount:         2,
-			TextReviewsCount:     3,
-			WorkRatingsCount:     4,
-			WorkReviewsCount:     5,
-			WorkTextReviewsCount: 6,
-			AverageRating:        "3.82",
-		},
-	}, counts)
+	assert.Equal(t, responses.Review{
+		ID:     "review-id",
+		User:   responses.User{ID: "user-id"},
+		Book:   responses.AuthorBook{ID: "book-id"},
+		Rating: 3,
+	}, *r)
 }
 
-func TestClient_ReviewList(t *testing.T) {
+func TestClient_RecentReviews(t *testing.T) {
 	c, done := newTestClient(t, decodeTestCase{
-		expectURL: fmt.Sprintf("/review/list/user-id.xml?key=%s&order=d&page=1&per_page=200&search=search&shelf=read&sort=date_read&v=2", testAPIKey),
+		expectURL: fmt.Sprintf("/review/recent_reviews.xml?key=%s", testAPIKey),
 		response: `<response>
 			<reviews>
-				<review><id>review1</id><rating>1</rating></review>
-				<review><id>review2</id><rating>2</rating></review>
-				<review><id>review3</id><rating>3</rating></review>
+				<review><id>review1</id><rating>5</rating></review>
+				<review><id>review2</id><rating>1</rating></review>
 			</reviews>
 		</response>`,
 	})
 	defer done()
 
-	r, err := c.ReviewList("user-id", "read", "date_read", "search", "d", 1, 200)
+	r, err := c.RecentReviews()
 	assert.Nil(t, err)
 	assert.Equal(t, []responses.Review{
-		{ID: "review1", Rating: 1},
-		{ID: "review2", Rating: 2},
-		{ID: "review3", Rating: 3},
+		{ID: "review1", Rating: 5},
+		{ID: "review2", Rating: 1},
 	}, r)
 }
 
@@ -207,6 +1248,119 @@ func TestClient_SearchBooks(t *testing.T) {
 	}, books)
 }
 
+func TestClient_SearchWorks(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/search/index.xml?key=%s&q=hello&search%%5Bfield%%5D=title", testAPIKey),
+		response: `<response>
+		<search>
+		  <results>
+			<work>
+			  <id type="integer">1</id>
+			  <books_count type="integer">2</books_count>
+			  <average_rating>3.59</average_rating>
+			  <best_book type="Book">
+				<id type="integer">3</id>
+				<title>book1</title>
+				<author>
+				  <id type="integer">4</id>
+				  <name>Author 1</name>
+				</author>
+				<image_url>https://image1.jpg</image_url>
+			  </best_book>
+			</work>
+		  </results>
+		</search>
+	</response>`})
+	defer done()
+
+	works, err := c.SearchWorks("hello", 0, TitleField)
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.Work{
+		{
+			ID:            "1",
+			BooksCount:    2,
+			AverageRating: 3.59,
+			BestBook: responses.AuthorBook{
+				ID:       "3",
+				Title:    "book1",
+				ImageURL: "https://image1.jpg",
+				Authors: []responses.Author{
+					{ID: "4", Name: "Author 1"},
+				},
+			},
+		},
+	}, works)
+}
+
+func TestClient_CreateShelf(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPost,
+		expectURL:    "/user_shelves.xml",
+		expectBody:   fmt.Sprintf("key=%s&user_shelf%%5Bexclusive_flag%%5D=true&user_shelf%%5Bname%%5D=dnf&user_shelf%%5Bsortable_flag%%5D=false", testAPIKey),
+		response: `<user_shelf>
+			<id>shelf-id</id>
+			<name>dnf</name>
+			<exclusive_flag>true</exclusive_flag>
+			<sortable_flag>false</sortable_flag>
+		</user_shelf>`,
+	})
+	defer done()
+
+	s, err := c.CreateShelf("dnf", ShelfOptions{Exclusive: Bool(true), Sortable: Bool(false)})
+	assert.Nil(t, err)
+	assert.Equal(t, responses.UserShelf{
+		ID:            "shelf-id",
+		Name:          "dnf",
+		ExclusiveFlag: true,
+	}, *s)
+}
+
+func TestClient_UpdateShelf(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPut,
+		expectURL:    "/user_shelves/shelf-id.xml",
+		expectBody:   fmt.Sprintf("key=%s&user_shelf%%5Bfeatured%%5D=true&user_shelf%%5Bname%%5D=favorites", testAPIKey),
+		response: `<user_shelf>
+			<id>shelf-id</id>
+			<name>favorites</name>
+			<featured>true</featured>
+			<recommend_for>true</recommend_for>
+		</user_shelf>`,
+	})
+	defer done()
+
+	s, err := c.UpdateShelf("shelf-id", "favorites", ShelfOptions{Featured: Bool(true)})
+	assert.Nil(t, err)
+	assert.Equal(t, responses.UserShelf{
+		ID:           "shelf-id",
+		Name:         "favorites",
+		Featured:     true,
+		RecommendFor: true,
+	}, *s)
+
+	t.Run("without name", func(t *testing.T) {
+		c, done := newTestClient(t, decodeTestCase{
+			expectMethod: http.MethodPut,
+			expectURL:    "/user_shelves/shelf-id.xml",
+			expectBody:   fmt.Sprintf("key=%s&user_shelf%%5Bsortable_flag%%5D=true", testAPIKey),
+			response: `<user_shelf>
+				<id>shelf-id</id>
+				<name>favorites</name>
+				<sortable_flag>true</sortable_flag>
+			</user_shelf>`,
+		})
+		defer done()
+
+		s, err := c.UpdateShelf("shelf-id", "", ShelfOptions{Sortable: Bool(true)})
+		assert.Nil(t, err)
+		assert.Equal(t, responses.UserShelf{
+			ID:           "shelf-id",
+			Name:         "favorites",
+			SortableFlag: true,
+		}, *s)
+	})
+}
+
 func TestClient_ShelvesList(t *testing.T) {
 	c, done := newTestClient(t, decodeTestCase{
 		expectURL: fmt.Sprintf("/shelf/list.xml?key=%s&user_id=user-id", testAPIKey),
@@ -236,6 +1390,24 @@ func TestClient_UserShow(t *testing.T) {
 			<user>
 				<id>user-id</id>
 				<name>User Name</name>
+				<user_name>username</user_name>
+				<age>30</age>
+				<interests>running, reading</interests>
+				<favorite_books>Norwegian Wood, Kafka on the Shore</favorite_books>
+				<favorite_authors>
+					<author><id>3354</id><name>Haruki Murakami</name></author>
+				</favorite_authors>
+				<updates_rss_url><![CDATA[https://www.goodreads.com/user/updates_rss/user-id]]></updates_rss_url>
+				<updates>
+					<update type="userstatus">
+						<action_text>is on page 100</action_text>
+						<object><user_status><id>status1</id><page>100</page></user_status></object>
+					</update>
+				</updates>
+				<user_statuses>
+					<user_status><id>status1</id><page>100</page></user_status>
+				</user_statuses>
+				<private>false</private>
 			</user>
 		</response>`,
 	})
@@ -244,19 +1416,364 @@ func TestClient_UserShow(t *testing.T) {
 	u, err := c.UserShow("user-id")
 	assert.Nil(t, err)
 	assert.Equal(t, responses.User{
-		ID:   "user-id",
-		Name: "User Name",
+		ID:            "user-id",
+		Name:          "User Name",
+		UserName:      "username",
+		Age:           30,
+		Interests:     "running, reading",
+		FavoriteBooks: "Norwegian Wood, Kafka on the Shore",
+		FavoriteAuthors: []responses.Author{
+			{ID: "3354", Name: "Haruki Murakami"},
+		},
+		UpdatesRSSURL: "https://www.goodreads.com/user/updates_rss/user-id",
+		Updates: []responses.Update{
+			{
+				Type:       responses.UpdateTypeUserStatus,
+				ActionText: "is on page 100",
+				UserStatus: &responses.UserStatus{ID: "status1", Page: 100},
+			},
+		},
+		UserStatuses: []responses.UserStatus{
+			{ID: "status1", Page: 100},
+		},
 	}, *u)
+
+	t.Run("with private profile", func(t *testing.T) {
+		c, done := newTestClient(t, decodeTestCase{
+			expectURL: fmt.Sprintf("/user/show/private-id.xml?key=%s", testAPIKey),
+			response:  `<response><user><id>private-id</id><private>true</private></user></response>`,
+		})
+		defer done()
+
+		u, err := c.UserShow("private-id")
+		assert.Nil(t, err)
+		assert.Equal(t, responses.User{ID: "private-id", Private: true}, *u)
+	})
+}
+
+func TestClient_TopicShow(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/topic/show.xml?id=topic-id&key=%s", testAPIKey),
+		response: `<response>
+			<topic>
+				<id>topic-id</id>
+				<title>August Pick</title>
+				<subject_type>Group</subject_type>
+				<subject_id>group-id</subject_id>
+				<comments_count>2</comments_count>
+				<folder><id>folder-id</id><name>Monthly Reads</name></folder>
+				<author_user><id>user-id</id><name>User Name</name></author_user>
+				<comments>
+					<comment><id>comment1</id><body>Loved it.</body></comment>
+					<comment><id>comment2</id><body>Couldn't finish.</body></comment>
+				</comments>
+			</topic>
+		</response>`,
+	})
+	defer done()
+
+	topic, err := c.TopicShow("topic-id")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Topic{
+		ID:            "topic-id",
+		Title:         "August Pick",
+		SubjectType:   "Group",
+		SubjectID:     "group-id",
+		CommentsCount: 2,
+		Folder:        responses.GroupFolder{ID: "folder-id", Name: "Monthly Reads"},
+		Author:        responses.User{ID: "user-id", Name: "User Name"},
+		Comments: []responses.Comment{
+			{ID: "comment1", Body: "Loved it."},
+			{ID: "comment2", Body: "Couldn't finish."},
+		},
+	}, *topic)
+}
+
+func TestClient_TopicGroupFolder(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/topic/group_folder/folder-id.xml?group_id=group-id&key=%s&page=3", testAPIKey),
+		response: `<response>
+			<group_folder>
+				<topics>
+					<topic><id>topic1</id><title>Topic 1</title></topic>
+					<topic><id>topic2</id><title>Topic 2</title></topic>
+				</topics>
+			</group_folder>
+		</response>`,
+	})
+	defer done()
+
+	topics, err := c.TopicGroupFolder("folder-id", "group-id", 3)
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.Topic{
+		{ID: "topic1", Title: "Topic 1"},
+		{ID: "topic2", Title: "Topic 2"},
+	}, topics)
+}
+
+func TestClient_TopicUnreadGroup(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/topic/unread_group/group-id.xml?key=%s", testAPIKey),
+		response: `<response>
+			<group_folder>
+				<topics>
+					<topic><id>topic1</id><title>Unread Topic</title></topic>
+				</topics>
+			</group_folder>
+		</response>`,
+	})
+	defer done()
+
+	topics, err := c.TopicUnreadGroup("group-id")
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.Topic{
+		{ID: "topic1", Title: "Unread Topic"},
+	}, topics)
+}
+
+func TestClient_TopicCreate(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPost,
+		expectURL:    "/topic.xml",
+		expectBody:   fmt.Sprintf("comment%%5Bbody_usertext%%5D=Thoughts%%3F&key=%s&topic%%5Bfolder_id%%5D=folder-id&topic%%5Bquestion_flag%%5D=true&topic%%5Bsubject_id%%5D=group-id&topic%%5Bsubject_type%%5D=Group&topic%%5Btitle%%5D=August+Pick", testAPIKey),
+		response:     `<topic><id>topic-id</id><title>August Pick</title></topic>`,
+	})
+	defer done()
+
+	topic, err := c.TopicCreate(TopicSubjectGroup, "group-id", "folder-id", "August Pick", "Thoughts?", true)
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Topic{
+		ID:    "topic-id",
+		Title: "August Pick",
+	}, *topic)
+}
+
+func TestClient_UpdatesFriends(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/updates/friends.xml?key=%s&max_updates=3&update=reviews&update_filter=top_friends", testAPIKey),
+		response: `<response>
+			<updates>
+				<update type="review">
+					<action_text>rated a book</action_text>
+					<actor><id>user1</id></actor>
+					<action type="rating"><rating>4</rating></action>
+					<object><review><id>review-id</id><rating>4</rating></review></object>
+				</update>
+				<update type="readstatus">
+					<actor><id>user2</id></actor>
+					<object><read_status><id>read-status-id</id><status>read</status></read_status></object>
+				</update>
+				<update type="userstatus">
+					<actor><id>user3</id></actor>
+					<object><user_status><id>user-status-id</id><percent>50</percent></user_status></object>
+				</update>
+			</updates>
+		</response>`,
+	})
+	defer done()
+
+	u, err := c.UpdatesFriends(UpdateKindReviews, UpdateFilterTopFriends, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.Update{
+		{
+			Type:       responses.UpdateTypeReview,
+			ActionText: "rated a book",
+			Actor:      responses.User{ID: "user1"},
+			Rating:     4,
+			Review:     &responses.Review{ID: "review-id", Rating: 4},
+		},
+		{
+			Type:       responses.UpdateTypeReadStatus,
+			Actor:      responses.User{ID: "user2"},
+			ReadStatus: &responses.ReadStatus{ID: "read-status-id", Status: "read"},
+		},
+		{
+			Type:       responses.UpdateTypeUserStatus,
+			Actor:      responses.User{ID: "user3"},
+			UserStatus: &responses.UserStatus{ID: "user-status-id", Percent: 50},
+		},
+	}, u)
+}
+
+func TestClient_UserCompare(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/user/compare/user-id.xml?key=%s", testAPIKey),
+		response: `<response>
+			<compare>
+				<your_library_percent>12.5</your_library_percent>
+				<their_library_percent>25</their_library_percent>
+				<your_total_books_count>8</your_total_books_count>
+				<their_total_books_count>4</their_total_books_count>
+				<user><id>user-id</id><name>User Name</name></user>
+				<reviews>
+					<review>
+						<book><id>book-id</id><title>Book Title</title></book>
+						<your_review><id>review1</id><rating>4</rating><review>Good.</review></your_review>
+						<their_review><id>review2</id><rating>5</rating></their_review>
+					</review>
+				</reviews>
+			</compare>
+		</response>`,
+	})
+	defer done()
+
+	cmp, err := c.UserCompare("user-id")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.Comparison{
+		User:                 responses.User{ID: "user-id", Name: "User Name"},
+		YourLibraryPercent:   12.5,
+		TheirLibraryPercent:  25,
+		YourTotalBooksCount:  8,
+		TheirTotalBooksCount: 4,
+		Books: []responses.ComparedBook{
+			{
+				Book:        responses.AuthorBook{ID: "book-id", Title: "Book Title"},
+				YourReview:  responses.ComparedReview{ID: "review1", Rating: 4, Body: "Good."},
+				TheirReview: responses.ComparedReview{ID: "review2", Rating: 5},
+			},
+		},
+	}, *cmp)
+}
+
+func TestClient_UserFollowers(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/user/user-id/followers.xml?key=%s&page=1", testAPIKey),
+		response: `<response>
+			<followers>
+				<user><id>follower1</id><name>Follower 1</name></user>
+				<user><id>follower2</id><name>Follower 2</name></user>
+			</followers>
+		</response>`,
+	})
+	defer done()
+
+	f, err := c.UserFollowers("user-id", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.User{
+		{ID: "follower1", Name: "Follower 1"},
+		{ID: "follower2", Name: "Follower 2"},
+	}, f)
+}
+
+func TestClient_UserFollowing(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/user/user-id/following.xml?key=%s", testAPIKey),
+		response: `<response>
+			<following>
+				<user><id>following1</id><name>Following 1</name></user>
+			</following>
+		</response>`,
+	})
+	defer done()
+
+	f, err := c.UserFollowing("user-id", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.User{
+		{ID: "following1", Name: "Following 1"},
+	}, f)
+}
+
+func TestClient_UserStatusCreate(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodPost,
+		expectURL:    "/user_status.xml",
+		expectBody:   fmt.Sprintf("key=%s&user_status%%5Bbody%%5D=Halfway&user_status%%5Bbook_id%%5D=book-id&user_status%%5Bpercent%%5D=50", testAPIKey),
+		response: `<user_status>
+			<id>status-id</id>
+			<book_id>book-id</book_id>
+			<page nil="true"/>
+			<percent>50</percent>
+			<body>Halfway</body>
+		</user_status>`,
+	})
+	defer done()
+
+	s, err := c.UserStatusCreate("book-id", 0, 50, "Halfway")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.UserStatus{
+		ID:      "status-id",
+		BookID:  "book-id",
+		Percent: 50,
+		Body:    "Halfway",
+	}, *s)
+}
+
+func TestClient_UserStatusShow(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/user_status/show/status-id?format=xml&key=%s", testAPIKey),
+		response: `<response>
+			<user_status>
+				<id>status-id</id>
+				<page>120</page>
+				<percent>40</percent>
+				<book><id>book-id</id><num_pages>300</num_pages></book>
+			</user_status>
+		</response>`,
+	})
+	defer done()
+
+	s, err := c.UserStatusShow("status-id")
+	assert.Nil(t, err)
+	assert.Equal(t, responses.UserStatus{
+		ID:      "status-id",
+		Page:    120,
+		Percent: 40,
+		Book:    responses.AuthorBook{ID: "book-id", NumPages: 300},
+	}, *s)
+}
+
+func TestClient_UserStatusDestroy(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectMethod: http.MethodDelete,
+		expectURL:    "/user_status/destroy/status-id",
+		expectBody:   fmt.Sprintf("format=xml&key=%s", testAPIKey),
+	})
+	defer done()
+
+	err := c.UserStatusDestroy("status-id")
+	assert.Nil(t, err)
+}
+
+func TestClient_UserStatusIndex(t *testing.T) {
+	c, done := newTestClient(t, decodeTestCase{
+		expectURL: fmt.Sprintf("/user_status/index.xml?key=%s", testAPIKey),
+		response: `<response>
+			<user_statuses>
+				<user_status><id>status1</id><percent>10</percent></user_status>
+				<user_status><id>status2</id><page>42</page></user_status>
+			</user_statuses>
+		</response>`,
+	})
+	defer done()
+
+	s, err := c.UserStatusIndex()
+	assert.Nil(t, err)
+	assert.Equal(t, []responses.UserStatus{
+		{ID: "status1", Percent: 10},
+		{ID: "status2", Page: 42},
+	}, s)
 }
 
 type decodeTestCase struct {
-	expectURL string
-	response  string
+	expectMethod string
+	expectURL    string
+	expectBody   string
+	response     string
 }
 
 func newTestClient(t *testing.T, tc decodeTestCase) (*Client, func()) {
 	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expectMethod := tc.expectMethod
+		if expectMethod == "" {
+			expectMethod = http.MethodGet
+		}
+		assert.Equal(t, expectMethod, r.Method)
 		assert.Equal(t, tc.expectURL, r.URL.String())
+
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, tc.expectBody, string(body))
+
 		_, _ = w.Write([]byte(tc.response))
 	}))
 
@@ -269,3 +1786,24 @@ func newTestClient(t *testing.T, tc decodeTestCase) (*Client, func()) {
 		},
 	}, s.Close
 }
+
+// newRoutedTestClient returns a client for methods that make multiple requests,
+// responding to each request according to its path.
+func newRoutedTestClient(t *testing.T, routes map[string]string) (*Client, func()) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res, ok := routes[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request: %s", r.URL)
+			return
+		}
+		_, _ = w.Write([]byte(res))
+	}))
+
+	return &Client{
+		APIKey: testAPIKey,
+		httpClient: &httpClient{
+			Client:  http.DefaultClient,
+			APIRoot: s.URL,
+		},
+	}, s.Close
+}
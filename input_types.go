@@ -1,5 +1,12 @@
 package goodreads
 
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/KyleBanks/goodreads/responses"
+)
+
 // SearchField defines the field types within which you can search.
 // Defaults to AllFields.
 type SearchField string
@@ -14,3 +21,211 @@ const (
 	// AllFields (the default) lets you search over everything.
 	AllFields SearchField = "all"
 )
+
+// CommentResource defines the types of resources that can be commented on.
+type CommentResource string
+
+const (
+	// CommentResourceAuthorBlogPost is a blog post written by an author.
+	CommentResourceAuthorBlogPost CommentResource = "author_blog_post"
+
+	// CommentResourceFanship is a user becoming a fan of an author.
+	CommentResourceFanship CommentResource = "fanship"
+
+	// CommentResourceList is a Listopia list.
+	CommentResourceList CommentResource = "list"
+
+	// CommentResourceOwnedBook is a book owned by a user.
+	CommentResourceOwnedBook CommentResource = "owned_book"
+
+	// CommentResourceQuestion is a question asked of an author.
+	CommentResourceQuestion CommentResource = "question"
+
+	// CommentResourceRating is a user's rating of a book.
+	CommentResourceRating CommentResource = "rating"
+
+	// CommentResourceReadStatus is a change in a user's reading status.
+	CommentResourceReadStatus CommentResource = "read_status"
+
+	// CommentResourceRecommendation is a book recommended by one user to another.
+	CommentResourceRecommendation CommentResource = "recommendation"
+
+	// CommentResourceReview is a user's review of a book.
+	CommentResourceReview CommentResource = "review"
+
+	// CommentResourceTopic is a discussion topic.
+	CommentResourceTopic CommentResource = "topic"
+
+	// CommentResourceUserChallenge is a user's reading challenge.
+	CommentResourceUserChallenge CommentResource = "user_challenge"
+
+	// CommentResourceUserQuote is a quote liked by a user.
+	CommentResourceUserQuote CommentResource = "user_quote"
+
+	// CommentResourceUserStatus is a user's progress update on a book.
+	CommentResourceUserStatus CommentResource = "user_status"
+)
+
+// FriendsSort defines the orderings available when listing a user's friends.
+type FriendsSort string
+
+const (
+	// FriendsSortFirstName orders friends alphabetically by first name.
+	FriendsSortFirstName FriendsSort = "first_name"
+
+	// FriendsSortDateAdded orders friends by when they became friends.
+	FriendsSortDateAdded FriendsSort = "date_added"
+
+	// FriendsSortLastOnline orders friends by when they were last active.
+	FriendsSortLastOnline FriendsSort = "last_online"
+)
+
+// GroupSort defines the orderings available when listing a user's groups.
+type GroupSort string
+
+const (
+	// GroupSortMyActivity orders groups by the user's most recent activity in them.
+	GroupSortMyActivity GroupSort = "my_activity"
+
+	// GroupSortMembers orders groups by the number of members.
+	GroupSortMembers GroupSort = "members"
+
+	// GroupSortLastActivity orders groups by their most recent activity.
+	GroupSortLastActivity GroupSort = "last_activity"
+
+	// GroupSortTitle orders groups alphabetically by title.
+	GroupSortTitle GroupSort = "title"
+)
+
+// GroupMembersSort defines the orderings available when listing the members of a group.
+type GroupMembersSort string
+
+const (
+	// GroupMembersSortLastOnline orders members by when they were last active.
+	GroupMembersSortLastOnline GroupMembersSort = "last_online"
+
+	// GroupMembersSortNumComments orders members by the number of comments they've made.
+	GroupMembersSortNumComments GroupMembersSort = "num_comments"
+
+	// GroupMembersSortDateJoined orders members by when they joined the group.
+	GroupMembersSortDateJoined GroupMembersSort = "date_joined"
+
+	// GroupMembersSortTitle orders members by their title within the group.
+	GroupMembersSortTitle GroupMembersSort = "title"
+)
+
+// TopicSubject defines the types of resources that a discussion topic can belong to.
+type TopicSubject string
+
+const (
+	// TopicSubjectBook is a topic discussing a book.
+	TopicSubjectBook TopicSubject = "Book"
+
+	// TopicSubjectGroup is a topic within a group's discussion board.
+	TopicSubjectGroup TopicSubject = "Group"
+)
+
+// OwnedBookOptions defines the optional details of a physical copy of a
+// book when adding or updating it.
+type OwnedBookOptions struct {
+	// Condition of the copy, omitted when zero.
+	Condition responses.BookCondition
+
+	// PurchaseDate is the date the copy was originally purchased,
+	// formatted as YYYY-MM-DD.
+	PurchaseDate string
+
+	// PurchaseLocation is where the copy was originally purchased.
+	PurchaseLocation string
+
+	// UniqueCode is the BookCrossing ID or other code identifying the copy.
+	UniqueCode string
+}
+
+func (o OwnedBookOptions) setValues(v url.Values) {
+	if o.Condition != 0 {
+		v.Set("owned_book[condition_code]", strconv.Itoa(int(o.Condition)))
+	}
+	if o.PurchaseDate != "" {
+		v.Set("owned_book[original_purchase_date]", o.PurchaseDate)
+	}
+	if o.PurchaseLocation != "" {
+		v.Set("owned_book[original_purchase_location]", o.PurchaseLocation)
+	}
+	if o.UniqueCode != "" {
+		v.Set("owned_book[unique_code]", o.UniqueCode)
+	}
+}
+
+// UpdateKind defines the kinds of updates that can be requested from the friend updates feed.
+type UpdateKind string
+
+const (
+	// UpdateKindBooks requests updates about books being shelved and read.
+	UpdateKindBooks UpdateKind = "books"
+
+	// UpdateKindReviews requests updates about reviews and ratings.
+	UpdateKindReviews UpdateKind = "reviews"
+
+	// UpdateKindStatuses requests progress updates on books being read.
+	UpdateKindStatuses UpdateKind = "statuses"
+)
+
+// UpdateFilter defines whose updates are included in the friend updates feed.
+type UpdateFilter string
+
+const (
+	// UpdateFilterFriends includes updates from all of the user's friends.
+	UpdateFilterFriends UpdateFilter = "friends"
+
+	// UpdateFilterFollowing includes updates from the people the user follows.
+	UpdateFilterFollowing UpdateFilter = "following"
+
+	// UpdateFilterTopFriends includes updates from the user's top friends only.
+	UpdateFilterTopFriends UpdateFilter = "top_friends"
+)
+
+// ShelfOptions defines the optional settings of a shelf when creating
+// or updating it. Settings left nil are not sent, so the Goodreads default
+// is used for a new shelf and the current value is kept for an existing one.
+type ShelfOptions struct {
+	// Exclusive shelves, such as read and to-read, can only be
+	// one of at a time per book.
+	Exclusive *bool
+
+	// Sortable shelves allow their books to be manually ordered.
+	Sortable *bool
+
+	// Featured shelves are displayed on the user's profile.
+	Featured *bool
+
+	// RecommendFor marks the shelf as a list of books to recommend.
+	RecommendFor *bool
+}
+
+func (o ShelfOptions) setValues(v url.Values, name string) {
+	if name != "" {
+		v.Set("user_shelf[name]", name)
+	}
+	for key, flag := range map[string]*bool{
+		"user_shelf[exclusive_flag]": o.Exclusive,
+		"user_shelf[sortable_flag]":  o.Sortable,
+		"user_shelf[featured]":       o.Featured,
+		"user_shelf[recommend_for]":  o.RecommendFor,
+	} {
+		if flag != nil {
+			v.Set(key, strconv.FormatBool(*flag))
+		}
+	}
+}
+
+// Bool returns a pointer to b, for setting the optional fields of ShelfOptions.
+func Bool(b bool) *bool {
+	return &b
+}
+
+// Int returns a pointer to i, for optional arguments such as the rating
+// passed to ReviewEdit.
+func Int(i int) *int {
+	return &i
+}
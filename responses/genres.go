@@ -0,0 +1,150 @@
+package responses
+
+import (
+	"sort"
+	"strings"
+)
+
+// Genre defines a canonical genre that noisy, user-created shelf names are mapped to.
+type Genre string
+
+// The genres that shelves are classified into.
+const (
+	GenreBiography         Genre = "biography"
+	GenreBusiness          Genre = "business"
+	GenreChildrens         Genre = "childrens"
+	GenreClassics          Genre = "classics"
+	GenreComics            Genre = "comics"
+	GenreFantasy           Genre = "fantasy"
+	GenreFiction           Genre = "fiction"
+	GenreHistoricalFiction Genre = "historical-fiction"
+	GenreHistory           Genre = "history"
+	GenreHorror            Genre = "horror"
+	GenreHumor             Genre = "humor"
+	GenreLiteraryFiction   Genre = "literary-fiction"
+	GenreMystery           Genre = "mystery"
+	GenreNonFiction        Genre = "non-fiction"
+	GenrePhilosophy        Genre = "philosophy"
+	GenrePoetry            Genre = "poetry"
+	GenreReligion          Genre = "religion"
+	GenreRomance           Genre = "romance"
+	GenreScience           Genre = "science"
+	GenreScienceFiction    Genre = "science-fiction"
+	GenreSelfHelp          Genre = "self-help"
+	GenreThriller          Genre = "thriller"
+	GenreYoungAdult        Genre = "young-adult"
+)
+
+// genreRules map keywords found at the start of a word in a shelf name to a
+// genre, with a trailing "-" requiring the whole word to match. Rules are
+// checked in order, so more specific keywords must come before those they
+// contain, such as "science-fiction" before "science" and "fiction".
+var genreRules = []struct {
+	keyword string
+	genre   Genre
+}{
+	{"historical-fiction", GenreHistoricalFiction},
+	{"historical-romance", GenreRomance},
+	{"historical-", GenreHistoricalFiction},
+	{"literary-fiction", GenreLiteraryFiction},
+	{"lit-fic", GenreLiteraryFiction},
+	{"literature", GenreLiteraryFiction},
+	{"science-fiction", GenreScienceFiction},
+	{"sci-fi", GenreScienceFiction},
+	{"scifi", GenreScienceFiction},
+	{"sciencefiction", GenreScienceFiction},
+	{"sf-", GenreScienceFiction},
+	{"non-fiction", GenreNonFiction},
+	{"nonfiction", GenreNonFiction},
+	{"true-crime", GenreNonFiction},
+	{"young-adult", GenreYoungAdult},
+	{"ya-", GenreYoungAdult},
+	{"teen", GenreYoungAdult},
+	{"children", GenreChildrens},
+	{"kids", GenreChildrens},
+	{"picture-book", GenreChildrens},
+	{"middle-grade", GenreChildrens},
+	{"graphic-novel", GenreComics},
+	{"comic", GenreComics},
+	{"manga", GenreComics},
+	{"fantasy", GenreFantasy},
+	{"mystery", GenreMystery},
+	{"mysteries", GenreMystery},
+	{"crime", GenreMystery},
+	{"detective", GenreMystery},
+	{"thriller", GenreThriller},
+	{"suspense", GenreThriller},
+	{"romance", GenreRomance},
+	{"horror", GenreHorror},
+	{"classic", GenreClassics},
+	{"autobiography", GenreBiography},
+	{"biography", GenreBiography},
+	{"memoir", GenreBiography},
+	{"history", GenreHistory},
+	{"science", GenreScience},
+	{"philosophy", GenrePhilosophy},
+	{"poetry", GenrePoetry},
+	{"self-help", GenreSelfHelp},
+	{"personal-development", GenreSelfHelp},
+	{"business", GenreBusiness},
+	{"religion", GenreReligion},
+	{"christian", GenreReligion},
+	{"spirituality", GenreReligion},
+	{"humor", GenreHumor},
+	{"humour", GenreHumor},
+	{"comedy", GenreHumor},
+	{"fiction", GenreFiction},
+}
+
+// GenreWeight defines a genre along with the confidence that a book belongs to it.
+type GenreWeight struct {
+	Genre  Genre   `json:"genre"`
+	Weight float64 `json:"weight"`
+}
+
+// ShelfGenre returns the genre that a shelf name maps to, and false if it
+// doesn't map to any, as with shelves such as to-read, currently-reading,
+// owned and favorites.
+func ShelfGenre(name string) (Genre, bool) {
+	name = "-" + strings.NewReplacer(" ", "-", "_", "-").Replace(strings.ToLower(strings.TrimSpace(name))) + "-"
+	for _, r := range genreRules {
+		if strings.Contains(name, "-"+r.keyword) {
+			return r.genre, true
+		}
+	}
+	return "", false
+}
+
+// ClassifyGenres maps popular shelves to genres, weighted by the share of
+// genre shelvings that each accounts for, such that the weights sum to 1.
+// Shelves that don't map to a genre are ignored, and the genres are sorted
+// from most to least likely.
+func ClassifyGenres(shelves []PopularShelf) []GenreWeight {
+	counts := make(map[Genre]int)
+	var total int
+	for _, s := range shelves {
+		g, ok := ShelfGenre(s.Name)
+		if !ok || s.Count <= 0 {
+			continue
+		}
+		counts[g] += s.Count
+		total += s.Count
+	}
+
+	genres := make([]GenreWeight, 0, len(counts))
+	for g, c := range counts {
+		genres = append(genres, GenreWeight{Genre: g, Weight: float64(c) / float64(total)})
+	}
+	sort.Slice(genres, func(i, j int) bool {
+		if genres[i].Weight != genres[j].Weight {
+			return genres[i].Weight > genres[j].Weight
+		}
+		return genres[i].Genre < genres[j].Genre
+	})
+	return genres
+}
+
+// Genres returns the likely genres of the book based on its popular shelves.
+func (b AuthorBook) Genres() []GenreWeight {
+	return ClassifyGenres(b.PopularShelves)
+}
@@ -0,0 +1,94 @@
+package responses
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShelfGenre(t *testing.T) {
+	testCases := []struct {
+		Shelf  string
+		Expect Genre
+	}{
+		{"fantasy", GenreFantasy},
+		{"urban-fantasy", GenreFantasy},
+		{"sci-fi", GenreScienceFiction},
+		{"Science Fiction", GenreScienceFiction},
+		{"sciencefiction", GenreScienceFiction},
+		{"sf", GenreScienceFiction},
+		{"science", GenreScience},
+		{"historical-fiction", GenreHistoricalFiction},
+		{"historical", GenreHistoricalFiction},
+		{"historical-romance", GenreRomance},
+		{"history", GenreHistory},
+		{"non-fiction", GenreNonFiction},
+		{"nonfiction", GenreNonFiction},
+		{"fiction", GenreFiction},
+		{"japanese-literature", GenreLiteraryFiction},
+		{"modern-classics", GenreClassics},
+		{"ya", GenreYoungAdult},
+		{"ya_fantasy", GenreYoungAdult},
+		{"graphic-novels", GenreComics},
+		{"memoirs", GenreBiography},
+		{"true-crime", GenreNonFiction},
+		{"crime", GenreMystery},
+		{"crime-fiction", GenreMystery},
+		{"to-read", ""},
+		{"currently-reading", ""},
+		{"owned", ""},
+		{"books-i-own", ""},
+		{"favorites", ""},
+		{"kindle", ""},
+		{"yoga", ""},
+		{"sfw", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Shelf, func(t *testing.T) {
+			g, ok := ShelfGenre(tc.Shelf)
+			assert.Equal(t, tc.Expect != "", ok)
+			assert.Equal(t, tc.Expect, g)
+		})
+	}
+}
+
+func TestClassifyGenres(t *testing.T) {
+	var b AuthorBook
+	err := xml.Unmarshal([]byte(`<book>
+		<popular_shelves>
+			<shelf name="to-read" count="500000"/>
+			<shelf name="currently-reading" count="20000"/>
+			<shelf name="fiction" count="6000"/>
+			<shelf name="favorites" count="5000"/>
+			<shelf name="japanese-literature" count="2000"/>
+			<shelf name="romance" count="1000"/>
+			<shelf name="literature" count="500"/>
+			<shelf name="owned" count="400"/>
+			<shelf name="contemporary" count="300"/>
+			<shelf name="japan" count="200"/>
+			<shelf name="classics" count="500"/>
+		</popular_shelves>
+	</book>`), &b)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []GenreWeight{
+		{Genre: GenreFiction, Weight: 0.6},
+		{Genre: GenreLiteraryFiction, Weight: 0.25},
+		{Genre: GenreRomance, Weight: 0.1},
+		{Genre: GenreClassics, Weight: 0.05},
+	}, b.Genres())
+
+	t.Run("without genre shelves", func(t *testing.T) {
+		assert.Empty(t, ClassifyGenres([]PopularShelf{{Name: "to-read", Count: 10}}))
+		assert.Empty(t, ClassifyGenres(nil))
+	})
+
+	t.Run("with tied weights", func(t *testing.T) {
+		assert.Equal(t, []GenreWeight{
+			{Genre: GenreFantasy, Weight: 0.5},
+			{Genre: GenreHorror, Weight: 0.5},
+		}, ClassifyGenres([]PopularShelf{{Name: "horror", Count: 5}, {Name: "fantasy", Count: 5}}))
+	})
+}
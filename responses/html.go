@@ -0,0 +1,370 @@
+package responses
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HTML is a fragment of HTML as written on Goodreads, such as a book
+// description or review body, that can be converted for display elsewhere.
+type HTML string
+
+// DescriptionHTML returns the description of the book as HTML.
+func (b AuthorBook) DescriptionHTML() HTML {
+	return HTML(b.Description)
+}
+
+// AboutHTML returns the biography of the author as HTML.
+func (a Author) AboutHTML() HTML {
+	return HTML(a.About)
+}
+
+// AboutHTML returns the profile description of the user as HTML.
+func (u User) AboutHTML() HTML {
+	return HTML(u.About)
+}
+
+// BodyHTML returns the body of the review as HTML.
+func (r Review) BodyHTML() HTML {
+	return HTML(r.Body)
+}
+
+// Text renders the HTML as plain text, with line breaks in place
+// of paragraphs and breaks, and all other tags removed.
+func (h HTML) Text() string {
+	var b strings.Builder
+	for _, t := range tokenizeHTML(string(h)) {
+		switch {
+		case t.tag == "":
+			b.WriteString(t.text)
+		case t.tag == "br":
+			b.WriteString("\n")
+		case t.tag == "li" && !t.closing:
+			b.WriteString("\n")
+		case isBlockTag(t.tag):
+			b.WriteString("\n\n")
+		}
+	}
+	return tidyLines(b.String())
+}
+
+// Markdown renders the HTML as Markdown, keeping emphasis, links and lists.
+// Text is escaped so that it can't be rendered as Markdown syntax or raw HTML.
+func (h HTML) Markdown() string {
+	var b strings.Builder
+	var links []string
+	for _, t := range tokenizeHTML(string(h)) {
+		switch t.tag {
+		case "":
+			text := escapeMarkdown(t.text)
+			if b.Len() == 0 || strings.HasSuffix(b.String(), "\n") {
+				text = escapeLineStart(text)
+			}
+			b.WriteString(text)
+		case "br":
+			b.WriteString("\n")
+		case "i", "em":
+			b.WriteString("*")
+		case "b", "strong":
+			b.WriteString("**")
+		case "li":
+			if !t.closing {
+				b.WriteString("\n- ")
+			}
+		case "a":
+			if !t.closing {
+				href := safeHref(t.href())
+				links = append(links, href)
+				if href != "" {
+					b.WriteString("[")
+				}
+			} else if len(links) > 0 {
+				href := links[len(links)-1]
+				links = links[:len(links)-1]
+				if href != "" {
+					b.WriteString("](" + linkEscaper.Replace(href) + ")")
+				}
+			}
+		default:
+			if isBlockTag(t.tag) {
+				b.WriteString("\n\n")
+			}
+		}
+	}
+	return tidyLines(b.String())
+}
+
+// safeTags are the tags kept by Safe, all of which are kept without attributes
+// other than the href of links.
+var safeTags = map[string]bool{
+	"a": true, "b": true, "blockquote": true, "br": true, "em": true, "i": true,
+	"li": true, "ol": true, "p": true, "strong": true, "u": true, "ul": true,
+}
+
+// Safe returns the HTML with only basic formatting tags and links kept, making
+// it safe to embed in a page. Scripts, styles, event handlers and any other
+// tags or attributes are removed, and unclosed tags are closed.
+func (h HTML) Safe() string {
+	var b strings.Builder
+	var open []string
+	for _, t := range tokenizeHTML(string(h)) {
+		switch {
+		case t.tag == "":
+			b.WriteString(html.EscapeString(t.text))
+		case !safeTags[t.tag]:
+		case t.tag == "br":
+			b.WriteString("<br />")
+		case !t.closing:
+			open = append(open, t.tag)
+			if href := safeHref(t.href()); t.tag == "a" && href != "" {
+				b.WriteString(`<a href="` + html.EscapeString(href) + `" rel="nofollow noopener">`)
+			} else {
+				b.WriteString("<" + t.tag + ">")
+			}
+		default:
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != t.tag {
+					continue
+				}
+				for len(open) > i {
+					b.WriteString("</" + open[len(open)-1] + ">")
+					open = open[:len(open)-1]
+				}
+				break
+			}
+		}
+	}
+	for len(open) > 0 {
+		b.WriteString("</" + open[len(open)-1] + ">")
+		open = open[:len(open)-1]
+	}
+	return b.String()
+}
+
+// Truncate shortens s to at most n characters, including a trailing ellipsis,
+// breaking on the last word boundary where possible.
+func Truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	if n <= 0 {
+		return ""
+	}
+
+	runes := []rune(s)
+	r := runes[:n-1]
+	if !unicode.IsSpace(runes[n-1]) {
+		for i := len(r) - 1; i > 0; i-- {
+			if unicode.IsSpace(r[i]) {
+				r = r[:i]
+				break
+			}
+		}
+	}
+	return strings.TrimRightFunc(string(r), func(c rune) bool {
+		return unicode.IsSpace(c) || unicode.IsPunct(c)
+	}) + "…"
+}
+
+type htmlToken struct {
+	tag     string
+	closing bool
+	attrs   string
+	text    string
+}
+
+var hrefAttr = regexp.MustCompile(`(?i)\bhref\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+))`)
+
+func (t htmlToken) href() string {
+	m := hrefAttr.FindStringSubmatch(t.attrs)
+	if m == nil {
+		return ""
+	}
+	return html.UnescapeString(m[1] + m[2] + m[3])
+}
+
+// tokenizeHTML splits s into tags and text, with whitespace in the text collapsed
+// and entities decoded. Comments, and scripts and styles along with their content,
+// are dropped.
+func tokenizeHTML(s string) []htmlToken {
+	var tokens []htmlToken
+	text := func(t string) {
+		if t = collapseSpace(html.UnescapeString(t)); t != "" {
+			tokens = append(tokens, htmlToken{text: t})
+		}
+	}
+
+	for len(s) > 0 {
+		i := indexTag(s)
+		if i < 0 {
+			text(s)
+			break
+		}
+		text(s[:i])
+		s = s[i:]
+
+		switch {
+		case strings.HasPrefix(s, "<!--"):
+			end := strings.Index(s, "-->")
+			if end < 0 {
+				return tokens
+			}
+			s = s[end+3:]
+			continue
+		case strings.HasPrefix(s, "<![CDATA["):
+			end := strings.Index(s, "]]>")
+			if end < 0 {
+				end = len(s)
+				s += "]]>"
+			}
+			if t := collapseSpace(s[9:end]); t != "" {
+				tokens = append(tokens, htmlToken{text: t})
+			}
+			s = s[end+3:]
+			continue
+		}
+
+		end := strings.IndexByte(s, '>')
+		if end < 0 {
+			text(s)
+			break
+		}
+		tok := parseTag(s[1:end])
+		s = s[end+1:]
+		if tok.tag == "" {
+			continue
+		}
+		if (tok.tag == "script" || tok.tag == "style") && !tok.closing {
+			end := strings.Index(strings.ToLower(s), "</"+tok.tag)
+			if end < 0 {
+				return tokens
+			}
+			s = s[end:]
+			continue
+		}
+		tokens = append(tokens, tok)
+	}
+	return tokens
+}
+
+// indexTag returns the index of the first tag, comment or declaration in s,
+// or -1 if there is none. A "<" that isn't followed by a letter, "/" and a
+// letter, or "!", such as in "I <3 this" or "5 < 6", is left as text.
+func indexTag(s string) int {
+	for i := 0; i+1 < len(s); i++ {
+		if s[i] != '<' {
+			continue
+		}
+		switch c := s[i+1]; {
+		case isASCIILetter(c), c == '!':
+			return i
+		case c == '/' && i+2 < len(s) && isASCIILetter(s[i+2]):
+			return i
+		}
+	}
+	return -1
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func parseTag(s string) htmlToken {
+	var t htmlToken
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "/") {
+		t.closing = true
+		s = s[1:]
+	}
+	s = strings.TrimSuffix(s, "/")
+
+	name := s
+	if i := strings.IndexFunc(s, unicode.IsSpace); i >= 0 {
+		name, t.attrs = s[:i], s[i:]
+	}
+	t.tag = strings.ToLower(name)
+	for _, c := range t.tag {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+			return htmlToken{}
+		}
+	}
+	return t
+}
+
+func isBlockTag(tag string) bool {
+	switch tag {
+	case "p", "div", "blockquote", "ul", "ol", "h1", "h2", "h3", "h4", "h5", "h6":
+		return true
+	}
+	return false
+}
+
+// safeHref returns the link if it uses a scheme that is safe to follow,
+// or an empty string otherwise.
+func safeHref(href string) string {
+	href = strings.TrimSpace(href)
+	lower := strings.ToLower(href)
+	for _, scheme := range []string{"http://", "https://", "mailto:", "/"} {
+		if strings.HasPrefix(lower, scheme) {
+			return href
+		}
+	}
+	return ""
+}
+
+// markdownEscaper escapes the characters with meaning in Markdown, along with
+// those that would otherwise let decoded text be rendered as raw HTML.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "`", "\\`",
+	"&", "&amp;", "<", "&lt;", ">", "&gt;",
+)
+
+var orderedListMarker = regexp.MustCompile(`^\d+[.)]`)
+
+// escapeLineStart escapes the characters that would start a heading, list,
+// rule or fenced block when they begin a line.
+func escapeLineStart(s string) string {
+	trimmed := strings.TrimLeft(s, " ")
+	if trimmed == "" {
+		return s
+	}
+	lead := s[:len(s)-len(trimmed)]
+	switch trimmed[0] {
+	case '#', '-', '+', '=', '~':
+		return lead + `\` + trimmed
+	}
+	if m := orderedListMarker.FindString(trimmed); m != "" {
+		return lead + m[:len(m)-1] + `\` + trimmed[len(m)-1:]
+	}
+	return s
+}
+
+// linkEscaper percent-encodes the characters that would end a Markdown
+// link destination early.
+var linkEscaper = strings.NewReplacer("(", "%28", ")", "%29", " ", "%20", "<", "%3C", ">", "%3E")
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+var spaces = regexp.MustCompile(`\s+`)
+
+// collapseSpace replaces each run of whitespace in s with a single space.
+func collapseSpace(s string) string {
+	return spaces.ReplaceAllString(s, " ")
+}
+
+var extraNewlines = regexp.MustCompile(`\n{3,}`)
+
+// tidyLines trims the whitespace around each line, and collapses runs of blank lines.
+func tidyLines(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimSpace(l)
+	}
+	s = strings.Join(lines, "\n")
+	return strings.TrimSpace(extraNewlines.ReplaceAllString(s, "\n\n"))
+}
@@ -0,0 +1,139 @@
+package responses
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A book description as returned by book.show, with its HTML in a CDATA section.
+const testBookXML = `<book>
+	<id>11297</id>
+	<description><![CDATA[Toru, a quiet and preternaturally serious young college student in Tokyo, is devoted to Naoko, a beautiful and introspective young woman, but their mutual passion is marked by the tragic death of their best friend years before. <br /><br />As Naoko retreats further into her own world, Toru finds himself drawn to a fiercely independent and sexually liberated young woman.<br /><br /><i>Norwegian Wood</i> is a <b>stunning</b> novel. See <a href="https://www.goodreads.com/author/show/3354.Haruki_Murakami" rel="nofollow">Haruki Murakami</a>.]]></description>
+</book>`
+
+// A review body as returned by review.show, including markup that is unsafe to embed.
+const testReviewXML = `<review>
+	<id>review-id</id>
+	<body><![CDATA[
+		I <em>loved</em> this &amp; would read it again.<br />
+		<p onclick="alert(1)">Favorite quote: <blockquote>If you only read the books that everyone else is reading...</blockquote></p>
+		<script>alert('xss')</script><a href="javascript:alert(1)">click me</a> <span style="color: red">5 * stars</span>
+		<b>unclosed
+	]]></body>
+</review>`
+
+func TestHTML_Text(t *testing.T) {
+	var b AuthorBook
+	assert.Nil(t, xml.Unmarshal([]byte(testBookXML), &b))
+	assert.Equal(t, "Toru, a quiet and preternaturally serious young college student in Tokyo, is devoted to Naoko, a beautiful and introspective young woman, but their mutual passion is marked by the tragic death of their best friend years before.\n\n"+
+		"As Naoko retreats further into her own world, Toru finds himself drawn to a fiercely independent and sexually liberated young woman.\n\n"+
+		"Norwegian Wood is a stunning novel. See Haruki Murakami.", b.DescriptionHTML().Text())
+
+	var r Review
+	assert.Nil(t, xml.Unmarshal([]byte(testReviewXML), &r))
+	assert.Equal(t, "I loved this & would read it again.\n\n"+
+		"Favorite quote:\n\n"+
+		"If you only read the books that everyone else is reading...\n\n"+
+		"click me 5 * stars unclosed", r.BodyHTML().Text())
+
+	t.Run("with less than signs", func(t *testing.T) {
+		h := HTML("I <3 this book. <b>Loved</b> it, 5 < 6 and 7 > 3.</")
+		assert.Equal(t, "I <3 this book. Loved it, 5 < 6 and 7 > 3.</", h.Text())
+	})
+}
+
+func TestHTML_Markdown(t *testing.T) {
+	var b AuthorBook
+	assert.Nil(t, xml.Unmarshal([]byte(testBookXML), &b))
+	assert.Equal(t, "Toru, a quiet and preternaturally serious young college student in Tokyo, is devoted to Naoko, a beautiful and introspective young woman, but their mutual passion is marked by the tragic death of their best friend years before.\n\n"+
+		"As Naoko retreats further into her own world, Toru finds himself drawn to a fiercely independent and sexually liberated young woman.\n\n"+
+		"*Norwegian Wood* is a **stunning** novel. See [Haruki Murakami](https://www.goodreads.com/author/show/3354.Haruki_Murakami).", b.DescriptionHTML().Markdown())
+
+	var r Review
+	assert.Nil(t, xml.Unmarshal([]byte(testReviewXML), &r))
+	assert.Equal(t, "I *loved* this &amp; would read it again.\n\n"+
+		"Favorite quote:\n\n"+
+		"If you only read the books that everyone else is reading...\n\n"+
+		`click me 5 \* stars **unclosed`, r.BodyHTML().Markdown())
+
+	t.Run("with list", func(t *testing.T) {
+		h := HTML("Favorites:<ul><li>Norwegian Wood</li><li>Kafka on the Shore</li></ul>")
+		assert.Equal(t, "Favorites:\n\n- Norwegian Wood\n- Kafka on the Shore", h.Markdown())
+	})
+
+	t.Run("with escaped HTML", func(t *testing.T) {
+		h := HTML("&lt;img src=x onerror=alert(1)&gt; &amp;")
+		assert.Equal(t, "&lt;img src=x onerror=alert(1)&gt; &amp;", h.Markdown())
+	})
+
+	t.Run("with less than signs", func(t *testing.T) {
+		h := HTML("I <3 this book. <b>Loved</b> it, 5 < 6 and 7 > 3.")
+		assert.Equal(t, `I &lt;3 this book. **Loved** it, 5 &lt; 6 and 7 &gt; 3.`, h.Markdown())
+	})
+
+	t.Run("with block markers at line start", func(t *testing.T) {
+		h := HTML("# Not a heading<br>- not a list<p>1. not ordered</p>2) nor this, but 3. and - are fine")
+		assert.Equal(t, "\\# Not a heading\n\\- not a list\n\n1\\. not ordered\n\n2\\) nor this, but 3. and - are fine", h.Markdown())
+	})
+
+	t.Run("with parentheses in link", func(t *testing.T) {
+		h := HTML(`<a href="https://a.com/x)y (z)">l</a>`)
+		assert.Equal(t, "[l](https://a.com/x%29y%20%28z%29)", h.Markdown())
+	})
+}
+
+func TestHTML_Safe(t *testing.T) {
+	var r Review
+	assert.Nil(t, xml.Unmarshal([]byte(testReviewXML), &r))
+	assert.Equal(t, ` I <em>loved</em> this &amp; would read it again.<br /> `+
+		`<p>Favorite quote: <blockquote>If you only read the books that everyone else is reading...</blockquote></p> `+
+		`<a>click me</a> 5 * stars <b>unclosed </b>`, r.BodyHTML().Safe())
+
+	var b AuthorBook
+	assert.Nil(t, xml.Unmarshal([]byte(testBookXML), &b))
+	assert.Contains(t, b.DescriptionHTML().Safe(), `See <a href="https://www.goodreads.com/author/show/3354.Haruki_Murakami" rel="nofollow noopener">Haruki Murakami</a>.`)
+
+	t.Run("with less than signs", func(t *testing.T) {
+		h := HTML("I <3 this book. <b>Loved</b> it, 5 < 6 and 7 > 3.")
+		assert.Equal(t, "I &lt;3 this book. <b>Loved</b> it, 5 &lt; 6 and 7 &gt; 3.", h.Safe())
+	})
+
+	t.Run("with mismatched tags", func(t *testing.T) {
+		assert.Equal(t, "<b><i>text</i></b> more", HTML("<b><i>text</b></i> more").Safe())
+	})
+}
+
+func TestAuthor_AboutHTML(t *testing.T) {
+	a := Author{About: `Haruki Murakami was born in Kyoto.<br /><br />His work has been translated into <i>50</i> languages.`}
+	assert.Equal(t, "Haruki Murakami was born in Kyoto.\n\nHis work has been translated into 50 languages.", a.AboutHTML().Text())
+}
+
+func TestUser_AboutHTML(t *testing.T) {
+	u := User{About: `Reader &amp; runner.`}
+	assert.Equal(t, "Reader & runner.", u.AboutHTML().Text())
+}
+
+func TestTruncate(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Input  string
+		N      int
+		Expect string
+	}{
+		{"with short text", "Norwegian Wood", 20, "Norwegian Wood"},
+		{"with exact length", "Norwegian Wood", 14, "Norwegian Wood"},
+		{"on word boundary", "Norwegian Wood is a stunning novel.", 20, "Norwegian Wood is a…"},
+		{"with trailing punctuation", "Toru, a quiet student", 7, "Toru…"},
+		{"without word boundary", "Supercalifragilistic", 10, "Supercali…"},
+		{"with multibyte characters", "ノルウェイの森 by Murakami", 10, "ノルウェイの森…"},
+		{"with zero length", "Norwegian Wood", 0, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Expect, Truncate(tc.Input, tc.N))
+		})
+	}
+}
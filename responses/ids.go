@@ -0,0 +1,28 @@
+package responses
+
+// BookID identifies a single edition of a book.
+type BookID string
+
+// AuthorID identifies an author.
+type AuthorID string
+
+// WorkID identifies a work, which groups together every edition of a book.
+type WorkID string
+
+// UserID identifies a Goodreads user.
+type UserID string
+
+// AuthorID returns the ID of the author.
+func (a Author) AuthorID() AuthorID {
+	return AuthorID(a.ID)
+}
+
+// BookID returns the ID of the book.
+func (b AuthorBook) BookID() BookID {
+	return BookID(b.ID)
+}
+
+// UserID returns the ID of the user.
+func (u User) UserID() UserID {
+	return UserID(u.ID)
+}
@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthor_AuthorID(t *testing.T) {
+	assert.Equal(t, AuthorID("3354"), Author{ID: "3354"}.AuthorID())
+}
+
+func TestAuthorBook_BookID(t *testing.T) {
+	assert.Equal(t, BookID("9777"), AuthorBook{ID: "9777"}.BookID())
+}
+
+func TestUser_UserID(t *testing.T) {
+	assert.Equal(t, UserID("38763538"), User{ID: "38763538"}.UserID())
+}
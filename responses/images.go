@@ -0,0 +1,117 @@
+package responses
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ImageSize defines the sizes in which Goodreads serves images.
+type ImageSize int
+
+const (
+	// ImageSmall is the smallest size, as used in lists.
+	ImageSmall ImageSize = iota + 1
+
+	// ImageMedium is the default size, as returned in ImageURL fields.
+	ImageMedium
+
+	// ImageLarge is the largest available size.
+	ImageLarge
+)
+
+// Goodreads includes the size of an image in its path, such as "1327867561m"
+// for a medium book cover, or "1462249047p5" for a medium author photo.
+var imageSizePattern = regexp.MustCompile(`/(books|authors|users)/(\d+)([sml]|p\d)/`)
+
+var imageSizeSuffixes = map[string]map[ImageSize]string{
+	"books":   {ImageSmall: "s", ImageMedium: "m", ImageLarge: "l"},
+	"authors": {ImageSmall: "p2", ImageMedium: "p5", ImageLarge: "p7"},
+	"users":   {ImageSmall: "p2", ImageMedium: "p5", ImageLarge: "p7"},
+}
+
+// IsPlaceholderImage returns true if the URL is of the placeholder image that
+// Goodreads returns when there is no book cover or profile photo, or is empty.
+func IsPlaceholderImage(url string) bool {
+	return url == "" || strings.Contains(url, "/nophoto/")
+}
+
+// ResizeImageURL derives the URL of the given size of an image from the URL of
+// any other size of it. The URL is returned unchanged if it's a placeholder, or
+// isn't in a format known to have other sizes.
+func ResizeImageURL(url string, size ImageSize) string {
+	if IsPlaceholderImage(url) {
+		return url
+	}
+
+	m := imageSizePattern.FindStringSubmatchIndex(url)
+	if m == nil {
+		return url
+	}
+	suffix, ok := imageSizeSuffixes[url[m[2]:m[3]]][size]
+	if !ok {
+		return url
+	}
+	return url[:m[6]] + suffix + url[m[7]:]
+}
+
+// bestImageURL returns the first of the URLs, given in order of preference,
+// that isn't a placeholder, or an empty string if they all are.
+func bestImageURL(urls ...string) string {
+	for _, u := range urls {
+		if !IsPlaceholderImage(u) {
+			return u
+		}
+	}
+	return ""
+}
+
+// HasImage returns true if the book has a cover image.
+func (b AuthorBook) HasImage() bool {
+	return b.BestImageURL() != ""
+}
+
+// BestImageURL returns the largest available cover image of the book,
+// or an empty string if there is none.
+func (b AuthorBook) BestImageURL() string {
+	return bestImageURL(b.LargeImageURL, b.ImageURL, b.SmallImageURL)
+}
+
+// ImageURLOfSize returns the cover image of the book in the given size,
+// or an empty string if there is none.
+func (b AuthorBook) ImageURLOfSize(size ImageSize) string {
+	return ResizeImageURL(b.BestImageURL(), size)
+}
+
+// HasImage returns true if the author has a photo.
+func (a Author) HasImage() bool {
+	return a.BestImageURL() != ""
+}
+
+// BestImageURL returns the largest available photo of the author,
+// or an empty string if there is none.
+func (a Author) BestImageURL() string {
+	return bestImageURL(a.LargeImageURL, a.ImageURL, a.SmallImageURL)
+}
+
+// ImageURLOfSize returns the photo of the author in the given size,
+// or an empty string if there is none.
+func (a Author) ImageURLOfSize(size ImageSize) string {
+	return ResizeImageURL(a.BestImageURL(), size)
+}
+
+// HasImage returns true if the user has a profile photo.
+func (u User) HasImage() bool {
+	return u.BestImageURL() != ""
+}
+
+// BestImageURL returns the largest available profile photo of the user,
+// or an empty string if there is none.
+func (u User) BestImageURL() string {
+	return bestImageURL(u.ImageURL, u.SmallImageURL)
+}
+
+// ImageURLOfSize returns the profile photo of the user in the given size,
+// or an empty string if there is none.
+func (u User) ImageURLOfSize(size ImageSize) string {
+	return ResizeImageURL(u.BestImageURL(), size)
+}
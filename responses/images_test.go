@@ -0,0 +1,84 @@
+package responses
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testBookCover       = "https://images.gr-assets.com/books/1327867561m/11297.jpg"
+	testBookPlaceholder = "https://s.gr-assets.com/assets/nophoto/book/111x148-bcc042a9c91a29c1d680899eff700a03.png"
+	testAuthorPhoto     = "https://images.gr-assets.com/authors/1462249047p5/3354.jpg"
+	testUserPhoto       = "https://images.gr-assets.com/users/1530562232p2/38763538.jpg"
+	testUserPlaceholder = "https://s.gr-assets.com/assets/nophoto/user/u_111x148-9394ebedbb3c6c218f64be9549657029.png"
+)
+
+func TestIsPlaceholderImage(t *testing.T) {
+	assert.True(t, IsPlaceholderImage(testBookPlaceholder))
+	assert.True(t, IsPlaceholderImage(testUserPlaceholder))
+	assert.True(t, IsPlaceholderImage(""))
+	assert.False(t, IsPlaceholderImage(testBookCover))
+	assert.False(t, IsPlaceholderImage(testAuthorPhoto))
+}
+
+func TestResizeImageURL(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		URL    string
+		Size   ImageSize
+		Expect string
+	}{
+		{"with small book cover", testBookCover, ImageSmall, "https://images.gr-assets.com/books/1327867561s/11297.jpg"},
+		{"with large book cover", testBookCover, ImageLarge, "https://images.gr-assets.com/books/1327867561l/11297.jpg"},
+		{"with same size", testBookCover, ImageMedium, testBookCover},
+		{"with small author photo", testAuthorPhoto, ImageSmall, "https://images.gr-assets.com/authors/1462249047p2/3354.jpg"},
+		{"with large user photo", testUserPhoto, ImageLarge, "https://images.gr-assets.com/users/1530562232p7/38763538.jpg"},
+		{"with placeholder", testBookPlaceholder, ImageLarge, testBookPlaceholder},
+		{"with unknown format", "https://example.com/cover.jpg", ImageLarge, "https://example.com/cover.jpg"},
+		{"with unknown size", testBookCover, ImageSize(0), testBookCover},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Expect, ResizeImageURL(tc.URL, tc.Size))
+		})
+	}
+}
+
+func TestAuthorBook_Images(t *testing.T) {
+	b := AuthorBook{
+		ImageURL:      testBookCover,
+		SmallImageURL: "https://images.gr-assets.com/books/1327867561s/11297.jpg",
+		LargeImageURL: testBookPlaceholder,
+	}
+	assert.True(t, b.HasImage())
+	assert.Equal(t, testBookCover, b.BestImageURL())
+	assert.Equal(t, "https://images.gr-assets.com/books/1327867561l/11297.jpg", b.ImageURLOfSize(ImageLarge))
+
+	t.Run("without cover", func(t *testing.T) {
+		b := AuthorBook{ImageURL: testBookPlaceholder, SmallImageURL: testBookPlaceholder}
+		assert.False(t, b.HasImage())
+		assert.Equal(t, "", b.BestImageURL())
+		assert.Equal(t, "", b.ImageURLOfSize(ImageLarge))
+	})
+}
+
+func TestAuthor_Images(t *testing.T) {
+	a := Author{
+		ImageURL:      testAuthorPhoto,
+		LargeImageURL: "https://images.gr-assets.com/authors/1462249047p7/3354.jpg",
+	}
+	assert.True(t, a.HasImage())
+	assert.Equal(t, "https://images.gr-assets.com/authors/1462249047p7/3354.jpg", a.BestImageURL())
+	assert.Equal(t, "https://images.gr-assets.com/authors/1462249047p2/3354.jpg", a.ImageURLOfSize(ImageSmall))
+	assert.False(t, Author{}.HasImage())
+}
+
+func TestUser_Images(t *testing.T) {
+	u := User{ImageURL: testUserPlaceholder, SmallImageURL: testUserPhoto}
+	assert.True(t, u.HasImage())
+	assert.Equal(t, testUserPhoto, u.BestImageURL())
+	assert.Equal(t, "https://images.gr-assets.com/users/1530562232p5/38763538.jpg", u.ImageURLOfSize(ImageMedium))
+	assert.False(t, User{ImageURL: testUserPlaceholder}.HasImage())
+}
@@ -0,0 +1,159 @@
+package responses
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RatingDistribution defines the number of ratings a book has received of each
+// star, as decoded from Goodreads' rating_dist format of "5:1234|4:567|...|total:1905".
+type RatingDistribution struct {
+	// Counts holds the number of ratings of each star, where Counts[0]
+	// is the number of one star ratings.
+	Counts [5]int
+
+	// Total is the total number of ratings, as reported by Goodreads.
+	Total int
+}
+
+// UnmarshalText decodes a distribution in the rating_dist format. Parts that
+// can't be parsed are skipped, so that a malformed distribution never fails
+// the decoding of the book or work it belongs to.
+func (d *RatingDistribution) UnmarshalText(text []byte) error {
+	*d = RatingDistribution{}
+	for _, part := range strings.Split(strings.TrimSpace(string(text)), "|") {
+		kv := strings.SplitN(part, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		count, err := strconv.Atoi(strings.TrimSpace(kv[1]))
+		if err != nil {
+			continue
+		}
+		d.set(strings.TrimSpace(kv[0]), count)
+	}
+	return nil
+}
+
+// MarshalText encodes the distribution in the rating_dist format.
+func (d RatingDistribution) MarshalText() ([]byte, error) {
+	var parts []string
+	for stars := 5; stars >= 1; stars-- {
+		parts = append(parts, fmt.Sprintf("%d:%d", stars, d.Counts[stars-1]))
+	}
+	parts = append(parts, fmt.Sprintf("total:%d", d.Total))
+	return []byte(strings.Join(parts, "|")), nil
+}
+
+// MarshalJSON encodes the distribution as an object of the count of each
+// star, keyed "1" to "5", along with the "total".
+func (d RatingDistribution) MarshalJSON() ([]byte, error) {
+	counts := map[string]int{"total": d.Total}
+	for i, c := range d.Counts {
+		counts[strconv.Itoa(i+1)] = c
+	}
+	return json.Marshal(counts)
+}
+
+// UnmarshalJSON decodes a distribution encoded by MarshalJSON, or one in the
+// rating_dist format as a JSON string. Unknown keys are ignored.
+func (d *RatingDistribution) UnmarshalJSON(b []byte) error {
+	var text string
+	if err := json.Unmarshal(b, &text); err == nil {
+		return d.UnmarshalText([]byte(text))
+	}
+
+	var counts map[string]int
+	if err := json.Unmarshal(b, &counts); err != nil {
+		return err
+	}
+	*d = RatingDistribution{}
+	for k, c := range counts {
+		d.set(k, c)
+	}
+	return nil
+}
+
+// set records the count of the given key, either a number of stars from
+// 1 to 5 or "total", ignoring any other key.
+func (d *RatingDistribution) set(key string, count int) {
+	if key == "total" {
+		d.Total = count
+		return
+	}
+	if stars, err := strconv.Atoi(key); err == nil && stars >= 1 && stars <= 5 {
+		d.Counts[stars-1] = count
+	}
+}
+
+// Count returns the number of ratings of the given number of stars.
+func (d RatingDistribution) Count(stars int) int {
+	if stars < 1 || stars > 5 {
+		return 0
+	}
+	return d.Counts[stars-1]
+}
+
+// Mean returns the average rating, or zero if there are no ratings.
+func (d RatingDistribution) Mean() float64 {
+	var sum, n int
+	for i, c := range d.Counts {
+		sum += (i + 1) * c
+		n += c
+	}
+	if n == 0 {
+		return 0
+	}
+	return float64(sum) / float64(n)
+}
+
+// Median returns the median rating, or zero if there are no ratings.
+func (d RatingDistribution) Median() int {
+	var n int
+	for _, c := range d.Counts {
+		n += c
+	}
+	if n == 0 {
+		return 0
+	}
+
+	var seen int
+	for i, c := range d.Counts {
+		seen += c
+		if seen*2 >= n {
+			return i + 1
+		}
+	}
+	return 5
+}
+
+// Bayesian returns the average rating adjusted towards a prior mean, such as
+// the average rating across all books, as though the book had also received
+// priorWeight ratings of that mean. This ranks books with few ratings more
+// fairly against those with many.
+func (d RatingDistribution) Bayesian(priorMean, priorWeight float64) float64 {
+	var n int
+	for _, c := range d.Counts {
+		n += c
+	}
+	return bayesian(d.Mean(), float64(n), priorMean, priorWeight)
+}
+
+// Bayesian returns the average rating adjusted towards a prior mean, as
+// described by RatingDistribution.Bayesian.
+func (r ReviewCounts) Bayesian(priorMean, priorWeight float64) (float64, error) {
+	mean, err := strconv.ParseFloat(r.AverageRating, 64)
+	if err != nil {
+		return 0, err
+	}
+	return bayesian(mean, float64(r.RatingsCount), priorMean, priorWeight), nil
+}
+
+func bayesian(mean, n, priorMean, priorWeight float64) float64 {
+	if n+priorWeight == 0 {
+		return 0
+	}
+	return (priorMean*priorWeight + mean*n) / (priorWeight + n)
+}
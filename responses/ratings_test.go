@@ -0,0 +1,145 @@
+package responses
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRatingDistribution_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Input  string
+		Expect RatingDistribution
+	}{
+		{"with distribution", "5:1234|4:567|3:89|2:12|1:3|total:1905", RatingDistribution{Counts: [5]int{3, 12, 89, 567, 1234}, Total: 1905}},
+		{"with partial distribution", "5:10|total:10", RatingDistribution{Counts: [5]int{0, 0, 0, 0, 10}, Total: 10}},
+		{"with empty distribution", "", RatingDistribution{}},
+		{"with trailing separator", "5:10|total:10|", RatingDistribution{Counts: [5]int{0, 0, 0, 0, 10}, Total: 10}},
+		{"with invalid count", "5:many|4:2|total:10", RatingDistribution{Counts: [5]int{0, 0, 0, 2, 0}, Total: 10}},
+		{"with invalid rating", "6:10|total:10", RatingDistribution{Total: 10}},
+		{"with invalid format", "5-10", RatingDistribution{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var d RatingDistribution
+			assert.Nil(t, d.UnmarshalText([]byte(tc.Input)))
+			assert.Equal(t, tc.Expect, d)
+		})
+	}
+}
+
+func TestRatingDistribution_MarshalText(t *testing.T) {
+	d := RatingDistribution{Counts: [5]int{3, 12, 89, 567, 1234}, Total: 1905}
+	b, err := d.MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, "5:1234|4:567|3:89|2:12|1:3|total:1905", string(b))
+}
+
+func TestRatingDistribution_Decode(t *testing.T) {
+	var b AuthorBook
+	err := xml.Unmarshal([]byte(`<book>
+		<id>11297</id>
+		<work>
+			<id>4835472</id>
+			<rating_dist>5:4|4:3|3:2|2:0|1:1|total:10</rating_dist>
+		</work>
+	</book>`), &b)
+	assert.Nil(t, err)
+	assert.Equal(t, RatingDistribution{Counts: [5]int{1, 0, 2, 3, 4}, Total: 10}, b.RatingDist)
+
+	j, err := json.Marshal(b)
+	assert.Nil(t, err)
+	assert.Contains(t, string(j), `"rating_dist":{"1":1,"2":0,"3":2,"4":3,"5":4,"total":10}`)
+
+	var fromJSON AuthorBook
+	assert.Nil(t, json.Unmarshal(j, &fromJSON))
+	assert.Equal(t, b, fromJSON)
+
+	t.Run("with malformed distribution", func(t *testing.T) {
+		var b AuthorBook
+		err := xml.Unmarshal([]byte(`<book>
+			<id>11297</id>
+			<title>Norwegian Wood</title>
+			<work><rating_dist>5:4|4:x|total:10|</rating_dist></work>
+		</book>`), &b)
+		assert.Nil(t, err)
+		assert.Equal(t, "Norwegian Wood", b.Title)
+		assert.Equal(t, RatingDistribution{Counts: [5]int{0, 0, 0, 0, 4}, Total: 10}, b.RatingDist)
+	})
+}
+
+func TestRatingDistribution_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Input  string
+		Expect RatingDistribution
+	}{
+		{"with object", `{"1":1,"2":0,"3":2,"4":3,"5":4,"total":10}`, RatingDistribution{Counts: [5]int{1, 0, 2, 3, 4}, Total: 10}},
+		{"with unknown keys", `{"5":4,"6":1,"total":4}`, RatingDistribution{Counts: [5]int{0, 0, 0, 0, 4}, Total: 4}},
+		{"with rating_dist string", `"5:4|4:3|3:2|2:0|1:1|total:10"`, RatingDistribution{Counts: [5]int{1, 0, 2, 3, 4}, Total: 10}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var d RatingDistribution
+			assert.Nil(t, json.Unmarshal([]byte(tc.Input), &d))
+			assert.Equal(t, tc.Expect, d)
+		})
+	}
+
+	t.Run("with invalid JSON", func(t *testing.T) {
+		var d RatingDistribution
+		assert.NotNil(t, json.Unmarshal([]byte(`[1,2]`), &d))
+	})
+}
+
+func TestRatingDistribution_Count(t *testing.T) {
+	d := RatingDistribution{Counts: [5]int{1, 2, 3, 4, 5}}
+	assert.Equal(t, 1, d.Count(1))
+	assert.Equal(t, 5, d.Count(5))
+	assert.Equal(t, 0, d.Count(0))
+	assert.Equal(t, 0, d.Count(6))
+}
+
+func TestRatingDistribution_Stats(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Counts      [5]int
+		Mean        float64
+		Median      int
+		Bayesian    float64
+		PriorMean   float64
+		PriorWeight float64
+	}{
+		{"with even split", [5]int{0, 0, 0, 5, 5}, 4.5, 4, 4.25, 4, 10},
+		{"with skewed ratings", [5]int{1, 0, 2, 3, 4}, 3.9, 4, 3.95, 4, 10},
+		{"with single rating", [5]int{0, 0, 0, 0, 1}, 5, 5, 3.5, 3.35, 10},
+		{"without ratings", [5]int{}, 0, 0, 4, 4, 10},
+		{"without ratings or prior", [5]int{}, 0, 0, 0, 4, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			d := RatingDistribution{Counts: tc.Counts}
+			assert.InDelta(t, tc.Mean, d.Mean(), 0.0001)
+			assert.Equal(t, tc.Median, d.Median())
+			assert.InDelta(t, tc.Bayesian, d.Bayesian(tc.PriorMean, tc.PriorWeight), 0.0001)
+		})
+	}
+}
+
+func TestReviewCounts_Bayesian(t *testing.T) {
+	r := ReviewCounts{AverageRating: "4.50", RatingsCount: 10}
+	b, err := r.Bayesian(4, 10)
+	assert.Nil(t, err)
+	assert.InDelta(t, 4.25, b, 0.0001)
+
+	t.Run("with invalid average", func(t *testing.T) {
+		_, err := ReviewCounts{}.Bayesian(4, 10)
+		assert.NotNil(t, err)
+	})
+}
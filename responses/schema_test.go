@@ -0,0 +1,212 @@
+package responses_test
+
+import (
+	"encoding/json"
+	"flag"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KyleBanks/goodreads/responses"
+	"github.com/KyleBanks/goodreads/responses/work"
+	"github.com/stretchr/testify/assert"
+)
+
+var updateSchema = flag.Bool("update", false, "regenerate the JSON schema of the response types")
+
+// schemaVersion is bumped whenever a change to the response types would break
+// consumers of their JSON, such as a renamed or removed key.
+const schemaVersion = "v1"
+
+var schemaTypes = []interface{}{
+	responses.Author{},
+	responses.AuthorBook{},
+	responses.AuthorFollowing{},
+	responses.Comment{},
+	responses.ComparedBook{},
+	responses.ComparedReview{},
+	responses.Comparison{},
+	responses.Event{},
+	responses.Fanship{},
+	responses.FriendRequest{},
+	responses.GenreWeight{},
+	responses.Group{},
+	responses.GroupFolder{},
+	responses.GroupMember{},
+	responses.List{},
+	responses.Notification{},
+	responses.OwnedBook{},
+	responses.PopularShelf{},
+	responses.Quote{},
+	responses.RatingDistribution{},
+	responses.ReadStatus{},
+	responses.Recommendation{},
+	responses.Review{},
+	responses.ReviewCounts{},
+	responses.ReviewShelf{},
+	responses.Topic{},
+	responses.Update{},
+	responses.User{},
+	responses.UserShelf{},
+	responses.UserStatus{},
+	responses.Work{},
+	work.Author{},
+	work.Book{},
+	work.Work{},
+}
+
+// TestSchema ensures the checked in JSON schema matches the response types.
+// Run with -update to regenerate it after changing them.
+func TestSchema(t *testing.T) {
+	b, err := json.MarshalIndent(generateSchema(), "", "  ")
+	assert.Nil(t, err)
+	b = append(b, '\n')
+
+	path := filepath.Join("schema", schemaVersion+".json")
+	if *updateSchema {
+		assert.Nil(t, ioutil.WriteFile(path, b, 0644))
+	}
+
+	expect, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, string(expect), string(b), "schema is out of date, run: go test ./responses -run TestSchema -update")
+}
+
+// TestSchema_Types ensures every response type is included in the schema.
+func TestSchema_Types(t *testing.T) {
+	var paths []string
+	for _, pattern := range []string{"*.go", filepath.Join("work", "*.go")} {
+		matches, err := filepath.Glob(pattern)
+		assert.Nil(t, err)
+		for _, path := range matches {
+			if !strings.HasSuffix(path, "_test.go") {
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	var expect []string
+	for _, path := range paths {
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		assert.Nil(t, err)
+
+		for _, decl := range f.Decls {
+			g, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range g.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok || !ts.Name.IsExported() {
+					continue
+				}
+				if _, ok := ts.Type.(*ast.StructType); ok {
+					expect = append(expect, f.Name.Name+"."+ts.Name.Name)
+				}
+			}
+		}
+	}
+
+	var actual []string
+	for _, v := range schemaTypes {
+		actual = append(actual, reflect.TypeOf(v).String())
+	}
+	assert.ElementsMatch(t, expect, actual)
+}
+
+// TestSchema_Keys ensures the JSON keys mirror the Goodreads XML names: the
+// element or attribute name, or for a nested path, the outer element of a
+// list and the inner element of anything else.
+func TestSchema_Keys(t *testing.T) {
+	for _, v := range schemaTypes {
+		typ := reflect.TypeOf(v)
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			name := strings.Split(f.Tag.Get("xml"), ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+
+			path := strings.Split(name, ">")
+			expect := path[len(path)-1]
+			if f.Type.Kind() == reflect.Slice {
+				expect = path[0]
+			}
+			key := strings.Split(f.Tag.Get("json"), ",")[0]
+			assert.Equal(t, expect, key, "%s.%s", typ, f.Name)
+		}
+	}
+}
+
+func generateSchema() map[string]interface{} {
+	defs := make(map[string]interface{})
+	for _, v := range schemaTypes {
+		schemaFor(reflect.TypeOf(v), defs)
+	}
+
+	return map[string]interface{}{
+		"$schema":     "http://json-schema.org/draft-07/schema#",
+		"$id":         "https://github.com/KyleBanks/goodreads/responses/schema/" + schemaVersion + ".json",
+		"title":       "Goodreads API responses",
+		"definitions": defs,
+	}
+}
+
+func schemaFor(t reflect.Type, defs map[string]interface{}) map[string]interface{} {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == reflect.TypeOf(time.Time{}) {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+	if t == reflect.TypeOf(responses.RatingDistribution{}) {
+		name := t.String()
+		props := map[string]interface{}{"total": map[string]interface{}{"type": "integer"}}
+		for stars := 1; stars <= 5; stars++ {
+			props[strconv.Itoa(stars)] = map[string]interface{}{"type": "integer"}
+		}
+		defs[name] = map[string]interface{}{"type": "object", "properties": props}
+		return map[string]interface{}{"$ref": "#/definitions/" + name}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": schemaFor(t.Elem(), defs)}
+	case reflect.Struct:
+		name := t.String()
+		ref := map[string]interface{}{"$ref": "#/definitions/" + name}
+		if _, ok := defs[name]; ok {
+			return ref
+		}
+
+		props := make(map[string]interface{})
+		def := map[string]interface{}{"type": "object", "properties": props}
+		defs[name] = def
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			key := strings.Split(f.Tag.Get("json"), ",")[0]
+			if key == "" || key == "-" {
+				continue
+			}
+			props[key] = schemaFor(f.Type, defs)
+		}
+		return ref
+	}
+
+	panic("unsupported type in schema: " + t.String())
+}
@@ -1,63 +1,140 @@
 package responses
 
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
 type Author struct {
-	ID               string       `xml:"id"`
-	Name             string       `xml:"name"`
-	ImageURL         string       `xml:"image_url"`
-	SmallImageURL    string       `xml:"small_image_url"`
-	LargeImageURL    string       `xml:"large_image_url"`
-	Link             string       `xml:"link"`
-	AverageRating    float32      `xml:"average_rating"`
-	RatingsCount     int          `xml:"ratings_count"`
-	TextReviewsCount int          `xml:"text_reviews_count"`
-	FansCount        int          `xml:"fans_count"`
-	AuthorFollowers  int          `xml:"author_followers"`
-	About            string       `xml:"about"`
-	WorksCount       int          `xml:"works_count"`
-	Gender           string       `xml:"gender"`
-	Hometown         string       `xml:"hometown"`
-	BornAt           string       `xml:"born_at"`
-	DiedAt           string       `xml:"died_at"`
-	GoodreadsAuthor  bool         `xml:"goodreads_author"`
-	UserID           string       `xml:"user>user_id"`
-	Books            []AuthorBook `xml:"books>book"`
+	ID               string       `xml:"id" json:"id"`
+	Name             string       `xml:"name" json:"name"`
+	ImageURL         string       `xml:"image_url" json:"image_url"`
+	SmallImageURL    string       `xml:"small_image_url" json:"small_image_url"`
+	LargeImageURL    string       `xml:"large_image_url" json:"large_image_url"`
+	Link             string       `xml:"link" json:"link"`
+	AverageRating    float32      `xml:"average_rating" json:"average_rating"`
+	RatingsCount     int          `xml:"ratings_count" json:"ratings_count"`
+	TextReviewsCount int          `xml:"text_reviews_count" json:"text_reviews_count"`
+	FansCount        int          `xml:"fans_count" json:"fans_count"`
+	AuthorFollowers  int          `xml:"author_followers" json:"author_followers"`
+	About            string       `xml:"about" json:"about"`
+	WorksCount       int          `xml:"works_count" json:"works_count"`
+	Gender           string       `xml:"gender" json:"gender"`
+	Hometown         string       `xml:"hometown" json:"hometown"`
+	BornAt           string       `xml:"born_at" json:"born_at"`
+	DiedAt           string       `xml:"died_at" json:"died_at"`
+	GoodreadsAuthor  bool         `xml:"goodreads_author" json:"goodreads_author"`
+	UserID           string       `xml:"user>user_id" json:"user_id"`
+	Books            []AuthorBook `xml:"books>book" json:"books"`
+}
+
+// AuthorFollowing defines a user following an author, which
+// adds the author's updates to the user's feed.
+type AuthorFollowing struct {
+	ID        string `xml:"id" json:"id"`
+	CreatedAt string `xml:"created_at" json:"created_at"`
+	UpdatedAt string `xml:"updated_at" json:"updated_at"`
+	Author    Author `xml:"author" json:"author"`
+	User      User   `xml:"user" json:"user"`
+}
+
+// Fanship defines a user becoming a fan of an author.
+type Fanship struct {
+	ID        string `xml:"id" json:"id"`
+	CreatedAt string `xml:"created_at" json:"created_at"`
+	UpdatedAt string `xml:"updated_at" json:"updated_at"`
+	Author    Author `xml:"author" json:"author"`
+	User      User   `xml:"user" json:"user"`
 }
 
 type AuthorBook struct {
-	ID                 string   `xml:"id"`
-	ISBN               string   `xml:"isbn"`
-	ISBN13             string   `xml:"isbn13"`
-	TextReviewsCount   int      `xml:"text_reviews_count"`
-	URI                string   `xml:"uri"`
-	Title              string   `xml:"title"`
-	TitleWithoutSeries string   `xml:"title_without_series"`
-	ImageURL           string   `xml:"image_url"`
-	SmallImageURL      string   `xml:"small_image_url"`
-	LargeImageURL      string   `xml:"large_image_url"`
-	Link               string   `xml:"link"`
-	NumPages           int      `xml:"num_pages"`
-	Format             string   `xml:"format"`
-	EditionInformation string   `xml:"edition_information"`
-	Publisher          string   `xml:"publisher"`
-	PublicationDay     int      `xml:"publication_day"`
-	PublicationYear    int      `xml:"publication_year"`
-	PublicationMonth   int      `xml:"publication_month"`
-	AverageRating      float32  `xml:"average_rating"`
-	RatingsCount       int      `xml:"ratings_count"`
-	Description        string   `xml:"description"`
-	Authors            []Author `xml:"authors>author"`
+	ID                 string             `xml:"id" json:"id"`
+	ISBN               string             `xml:"isbn" json:"isbn"`
+	ISBN13             string             `xml:"isbn13" json:"isbn13"`
+	TextReviewsCount   int                `xml:"text_reviews_count" json:"text_reviews_count"`
+	URI                string             `xml:"uri" json:"uri"`
+	Title              string             `xml:"title" json:"title"`
+	TitleWithoutSeries string             `xml:"title_without_series" json:"title_without_series"`
+	ImageURL           string             `xml:"image_url" json:"image_url"`
+	SmallImageURL      string             `xml:"small_image_url" json:"small_image_url"`
+	LargeImageURL      string             `xml:"large_image_url" json:"large_image_url"`
+	Link               string             `xml:"link" json:"link"`
+	NumPages           int                `xml:"num_pages" json:"num_pages"`
+	Format             string             `xml:"format" json:"format"`
+	EditionInformation string             `xml:"edition_information" json:"edition_information"`
+	Publisher          string             `xml:"publisher" json:"publisher"`
+	PublicationDay     int                `xml:"publication_day" json:"publication_day"`
+	PublicationYear    int                `xml:"publication_year" json:"publication_year"`
+	PublicationMonth   int                `xml:"publication_month" json:"publication_month"`
+	AverageRating      float32            `xml:"average_rating" json:"average_rating"`
+	RatingsCount       int                `xml:"ratings_count" json:"ratings_count"`
+	RatingDist         RatingDistribution `xml:"work>rating_dist" json:"rating_dist"`
+	PopularShelves     []PopularShelf     `xml:"popular_shelves>shelf" json:"popular_shelves"`
+	Description        string             `xml:"description" json:"description"`
+	Authors            []Author           `xml:"authors>author" json:"authors"`
+}
+
+// Recommendation defines a book recommended by one user to another.
+type Recommendation struct {
+	ID        string     `xml:"id" json:"id"`
+	CreatedAt string     `xml:"created_at" json:"created_at"`
+	Message   string     `xml:"message" json:"message"`
+	FromUser  User       `xml:"from_user" json:"from_user"`
+	ToUser    User       `xml:"to_user" json:"to_user"`
+	Book      AuthorBook `xml:"book" json:"book"`
 }
 
 type Review struct {
-	ID          string     `xml:"id"`
-	Book        AuthorBook `xml:"book"`
-	Rating      int        `xml:"rating"`
-	StartedAt   string     `xml:"started_at"`
-	ReadAt      string     `xml:"read_at"`
-	DateAdded   string     `xml:"date_added"`
-	DateUpdated string     `xml:"date_updated"`
-	ReadCount   int        `xml:"read_count"`
-	Body        string     `xml:"body"`
+	ID             string        `xml:"id" json:"id"`
+	User           User          `xml:"user" json:"user"`
+	Book           AuthorBook    `xml:"book" json:"book"`
+	Rating         int           `xml:"rating" json:"rating"`
+	Votes          int           `xml:"votes" json:"votes"`
+	SpoilerFlag    bool          `xml:"spoiler_flag" json:"spoiler_flag"`
+	SpoilersState  string        `xml:"spoilers_state" json:"spoilers_state"`
+	Shelves        []ReviewShelf `xml:"shelves>shelf" json:"shelves"`
+	RecommendedFor string        `xml:"recommended_for" json:"recommended_for"`
+	RecommendedBy  string        `xml:"recommended_by" json:"recommended_by"`
+	StartedAt      string        `xml:"started_at" json:"started_at"`
+	ReadAt         string        `xml:"read_at" json:"read_at"`
+	DateAdded      string        `xml:"date_added" json:"date_added"`
+	DateUpdated    string        `xml:"date_updated" json:"date_updated"`
+	ReadCount      int           `xml:"read_count" json:"read_count"`
+	Body           string        `xml:"body" json:"body"`
+	CommentsCount  int           `xml:"comments_count" json:"comments_count"`
+	URL            string        `xml:"url" json:"url"`
+	Link           string        `xml:"link" json:"link"`
+	Owned          int           `xml:"owned" json:"owned"`
+	Comments       []Comment     `xml:"comments>comment" json:"comments"`
+}
+
+// OnShelf returns true if the reviewed book is on the named shelf.
+func (r Review) OnShelf(name string) bool {
+	for _, s := range r.Shelves {
+		if s.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ReviewShelf defines a shelf that a reviewed book is on.
+type ReviewShelf struct {
+	ID        string `xml:"id,attr" json:"id"`
+	Name      string `xml:"name,attr" json:"name"`
+	Exclusive bool   `xml:"exclusive,attr" json:"exclusive"`
+	Sortable  bool   `xml:"sortable,attr" json:"sortable"`
+}
+
+// Comment defines a comment left by a user on a review, status, topic
+// or other commentable resource.
+type Comment struct {
+	ID        string `xml:"id" json:"id"`
+	Body      string `xml:"body" json:"body"`
+	User      User   `xml:"user" json:"user"`
+	CreatedAt string `xml:"created_at" json:"created_at"`
+	UpdatedAt string `xml:"updated_at" json:"updated_at"`
 }
 
 // ReviewCounts defines the review statistics from the book.review_counts
@@ -75,28 +152,414 @@ type ReviewCounts struct {
 	AverageRating        string `json:"average_rating"`
 }
 
+// Comparison defines the books that two users have in common, as
+// compared from the perspective of the first ("your") user.
+type Comparison struct {
+	User                 User           `xml:"user" json:"user"`
+	YourLibraryPercent   float64        `xml:"your_library_percent" json:"your_library_percent"`
+	TheirLibraryPercent  float64        `xml:"their_library_percent" json:"their_library_percent"`
+	YourTotalBooksCount  int            `xml:"your_total_books_count" json:"your_total_books_count"`
+	TheirTotalBooksCount int            `xml:"their_total_books_count" json:"their_total_books_count"`
+	Books                []ComparedBook `xml:"reviews>review" json:"reviews"`
+}
+
+// ComparedBook defines a book that two users have in common, along with each user's review of it.
+type ComparedBook struct {
+	Book        AuthorBook     `xml:"book" json:"book"`
+	YourReview  ComparedReview `xml:"your_review" json:"your_review"`
+	TheirReview ComparedReview `xml:"their_review" json:"their_review"`
+}
+
+// ComparedReview defines a user's review of a book within a Comparison.
+type ComparedReview struct {
+	ID        string `xml:"id" json:"id"`
+	Rating    int    `xml:"rating" json:"rating"`
+	Body      string `xml:"review" json:"review"`
+	UpdatedAt string `xml:"updated_at" json:"updated_at"`
+}
+
+// CompareReviews builds a Comparison of two users from their reviews, such as
+// those returned by ReviewList, for when the user.compare endpoint is unavailable.
+// Books are matched by ID, and the User of the Comparison is left empty.
+func CompareReviews(yours, theirs []Review) Comparison {
+	c := Comparison{
+		YourTotalBooksCount:  len(yours),
+		TheirTotalBooksCount: len(theirs),
+	}
+
+	theirsByBook := make(map[string]Review, len(theirs))
+	for _, r := range theirs {
+		theirsByBook[r.Book.ID] = r
+	}
+	for _, r := range yours {
+		t, ok := theirsByBook[r.Book.ID]
+		if !ok {
+			continue
+		}
+		c.Books = append(c.Books, ComparedBook{
+			Book:        r.Book,
+			YourReview:  ComparedReview{ID: r.ID, Rating: r.Rating, Body: r.Body, UpdatedAt: r.DateUpdated},
+			TheirReview: ComparedReview{ID: t.ID, Rating: t.Rating, Body: t.Body, UpdatedAt: t.DateUpdated},
+		})
+	}
+
+	if len(yours) > 0 {
+		c.YourLibraryPercent = float64(len(c.Books)) / float64(len(yours)) * 100
+	}
+	if len(theirs) > 0 {
+		c.TheirLibraryPercent = float64(len(c.Books)) / float64(len(theirs)) * 100
+	}
+	return c
+}
+
+// Compatibility returns a score between 0 and 1 of how closely the two users
+// rated the books they have in common, where 1 means every rating matched.
+// Books that either user hasn't rated are ignored, and zero is returned if
+// there are no such books.
+func (c Comparison) Compatibility() float64 {
+	var diff, count int
+	for _, b := range c.Books {
+		if b.YourReview.Rating == 0 || b.TheirReview.Rating == 0 {
+			continue
+		}
+		d := b.YourReview.Rating - b.TheirReview.Rating
+		if d < 0 {
+			d = -d
+		}
+		diff += d
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+
+	// Ratings range from 1 to 5, so the largest possible difference is 4.
+	return 1 - float64(diff)/float64(count)/4
+}
+
+// Event defines an in-person event, such as an author signing or book club meeting.
+type Event struct {
+	ID             string    `xml:"id" json:"id"`
+	Title          string    `xml:"title" json:"title"`
+	Description    string    `xml:"description" json:"description"`
+	EventType      string    `xml:"event_type" json:"event_type"`
+	Access         string    `xml:"access" json:"access"`
+	Venue          string    `xml:"venue" json:"venue"`
+	Address        string    `xml:"address" json:"address"`
+	City           string    `xml:"city" json:"city"`
+	StateCode      string    `xml:"state_code" json:"state_code"`
+	PostalCode     string    `xml:"postal_code" json:"postal_code"`
+	CountryCode    string    `xml:"country_code" json:"country_code"`
+	Link           string    `xml:"link" json:"link"`
+	ImageURL       string    `xml:"image_url" json:"image_url"`
+	ResourceType   string    `xml:"resource_type" json:"resource_type"`
+	ResourceID     string    `xml:"resource_id" json:"resource_id"`
+	ResponsesCount int       `xml:"event_responses_count" json:"event_responses_count"`
+	StartAt        time.Time `xml:"start_at" json:"start_at"`
+	EndAt          time.Time `xml:"end_at" json:"end_at"`
+}
+
+// eventTimeLayouts are the formats Goodreads has been seen to use for event times.
+var eventTimeLayouts = []string{
+	time.RFC3339,
+	"Mon Jan 02 15:04:05 -0700 2006",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// UnmarshalXML decodes an Event, parsing its start and end times and leaving
+// them as the zero time when not set or not in a known format.
+func (e *Event) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type event Event
+	var raw struct {
+		event
+		StartAt string `xml:"start_at"`
+		EndAt   string `xml:"end_at"`
+	}
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+
+	*e = Event(raw.event)
+	for _, t := range []struct {
+		dst *time.Time
+		src string
+	}{
+		{&e.StartAt, raw.StartAt},
+		{&e.EndAt, raw.EndAt},
+	} {
+		for _, layout := range eventTimeLayouts {
+			if parsed, err := time.Parse(layout, strings.TrimSpace(t.src)); err == nil {
+				*t.dst = parsed
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// FriendRequest defines a pending request from another user
+// to become friends.
+type FriendRequest struct {
+	ID        string `xml:"id" json:"id"`
+	CreatedAt string `xml:"created_at" json:"created_at"`
+	Message   string `xml:"message" json:"message"`
+	FromUser  User   `xml:"from_user" json:"from_user"`
+}
+
+// Group defines a Goodreads group, such as a book club.
+type Group struct {
+	ID                  string        `xml:"id" json:"id"`
+	Title               string        `xml:"title" json:"title"`
+	Access              string        `xml:"access" json:"access"`
+	Location            string        `xml:"location" json:"location"`
+	Category            string        `xml:"category" json:"category"`
+	Subcategory         string        `xml:"subcategory" json:"subcategory"`
+	Description         string        `xml:"description" json:"description"`
+	ImageURL            string        `xml:"image_url" json:"image_url"`
+	UsersCount          int           `xml:"users_count" json:"users_count"`
+	MembersCount        int           `xml:"members_count" json:"members_count"`
+	LastActivityAt      string        `xml:"last_activity_at" json:"last_activity_at"`
+	AcceptingNewMembers bool          `xml:"accepting_new_members_flag" json:"accepting_new_members_flag"`
+	Moderators          []GroupMember `xml:"moderators>group_user" json:"moderators"`
+	Folders             []GroupFolder `xml:"folders>folder" json:"folders"`
+}
+
+// GroupFolder defines a folder of discussion topics within a group.
+type GroupFolder struct {
+	ID         string `xml:"id" json:"id"`
+	Name       string `xml:"name" json:"name"`
+	ItemsCount int    `xml:"items_count" json:"items_count"`
+	SubCount   int    `xml:"sub_count" json:"sub_count"`
+	UpdatedAt  string `xml:"updated_at" json:"updated_at"`
+}
+
+// GroupMember defines a user's membership of a group.
+type GroupMember struct {
+	User          User   `xml:"user" json:"user"`
+	Title         string `xml:"title" json:"title"`
+	CommentsCount int    `xml:"comments_count" json:"comments_count"`
+	CreatedAt     string `xml:"created_at" json:"created_at"`
+	LastActiveAt  string `xml:"last_active_at" json:"last_active_at"`
+}
+
+// List defines a Listopia list of books voted on by users, along
+// with the standing of a particular book on the list when looked
+// up for that book.
+type List struct {
+	ID          string `xml:"id" json:"id"`
+	Title       string `xml:"title" json:"title"`
+	Description string `xml:"description" json:"description"`
+	BooksCount  int    `xml:"books_count" json:"books_count"`
+	VotersCount int    `xml:"voters_count" json:"voters_count"`
+	CreatedAt   string `xml:"created_at" json:"created_at"`
+	Rank        int    `xml:"rank" json:"rank"`
+	Votes       int    `xml:"votes" json:"votes"`
+}
+
+// Notification defines an alert to the authenticated user about activity
+// on their account, such as a comment on one of their reviews.
+type Notification struct {
+	Actors            []User `xml:"actors>user" json:"actors"`
+	New               bool   `xml:"new" json:"new"`
+	CreatedAt         string `xml:"created_at" json:"created_at"`
+	URL               string `xml:"url" json:"url"`
+	ResourceType      string `xml:"resource_type" json:"resource_type"`
+	GroupResourceType string `xml:"group_resource_type" json:"group_resource_type"`
+	Text              string `xml:"body>text" json:"text"`
+	HTML              string `xml:"body>html" json:"html"`
+}
+
+// BookCondition defines the physical condition of an owned book.
+type BookCondition int
+
+const (
+	// ConditionBrandNew is an unused book.
+	ConditionBrandNew BookCondition = 10
+
+	// ConditionLikeNew is a book with no visible wear.
+	ConditionLikeNew BookCondition = 20
+
+	// ConditionVeryGood is a book with minimal wear.
+	ConditionVeryGood BookCondition = 30
+
+	// ConditionGood is a book with some wear from regular use.
+	ConditionGood BookCondition = 40
+
+	// ConditionAcceptable is a worn book that is still complete and readable.
+	ConditionAcceptable BookCondition = 50
+
+	// ConditionPoor is a heavily worn or damaged book.
+	ConditionPoor BookCondition = 60
+)
+
+// OwnedBook defines a physical copy of a book owned by a user.
+type OwnedBook struct {
+	ID                       string        `xml:"id" json:"id"`
+	Book                     AuthorBook    `xml:"book" json:"book"`
+	ConditionCode            BookCondition `xml:"condition_code" json:"condition_code"`
+	Condition                string        `xml:"condition" json:"condition"`
+	OriginalPurchaseDate     string        `xml:"original_purchase_date" json:"original_purchase_date"`
+	OriginalPurchaseLocation string        `xml:"original_purchase_location" json:"original_purchase_location"`
+	UniqueCode               string        `xml:"unique_code" json:"unique_code"`
+	CurrentOwnerID           UserID        `xml:"current_owner_id" json:"current_owner_id"`
+	CurrentOwnerName         string        `xml:"current_owner_name" json:"current_owner_name"`
+	TradedCount              int           `xml:"traded_count" json:"traded_count"`
+	AvailableForSwap         bool          `xml:"available_for_swap" json:"available_for_swap"`
+}
+
+// PopularShelf defines a shelf name that many users have put a book on,
+// along with the number of users who did.
+type PopularShelf struct {
+	Name  string `xml:"name,attr" json:"name"`
+	Count int    `xml:"count,attr" json:"count"`
+}
+
+// Quote defines a passage quoted from a book or author.
+type Quote struct {
+	ID         string   `xml:"id" json:"id"`
+	Body       string   `xml:"body" json:"body"`
+	AuthorName string   `xml:"author_name" json:"author_name"`
+	AuthorID   AuthorID `xml:"author_id" json:"author_id"`
+	BookID     BookID   `xml:"book_id" json:"book_id"`
+	LikesCount int      `xml:"likes_count" json:"likes_count"`
+	CreatedAt  string   `xml:"created_at" json:"created_at"`
+}
+
+// ReadStatus defines a change in a user's reading status for a book,
+// such as moving it from to-read to currently-reading.
+type ReadStatus struct {
+	ID        string `xml:"id" json:"id"`
+	ReviewID  string `xml:"review_id" json:"review_id"`
+	UserID    UserID `xml:"user_id" json:"user_id"`
+	OldStatus string `xml:"old_status" json:"old_status"`
+	Status    string `xml:"status" json:"status"`
+	UpdatedAt string `xml:"updated_at" json:"updated_at"`
+	User      User   `xml:"user" json:"user"`
+	Review    Review `xml:"review" json:"review"`
+}
+
 type User struct {
-	ID            string      `xml:"id"`
-	Name          string      `xml:"name"`
-	Link          string      `xml:"link"`
-	ImageURL      string      `xml:"image_url"`
-	SmallImageURL string      `xml:"small_image_url"`
-	About         string      `xml:"about"`
-	Gender        string      `xml:"gender"`
-	Location      string      `xml:"location"`
-	Website       string      `xml:"website"`
-	Joined        string      `xml:"joined"`
-	LastActive    string      `xml:"last_active"`
-	FriendsCount  int         `xml:"friends_count"`
-	GroupsCount   int         `xml:"groups_count"`
-	ReviewCount   int         `xml:"reviews_count"`
-	UserShelves   []UserShelf `xml:"user_shelves>user_shelf"`
+	ID              string       `xml:"id" json:"id"`
+	Name            string       `xml:"name" json:"name"`
+	UserName        string       `xml:"user_name" json:"user_name"`
+	Link            string       `xml:"link" json:"link"`
+	ImageURL        string       `xml:"image_url" json:"image_url"`
+	SmallImageURL   string       `xml:"small_image_url" json:"small_image_url"`
+	About           string       `xml:"about" json:"about"`
+	Age             int          `xml:"age" json:"age"`
+	Gender          string       `xml:"gender" json:"gender"`
+	Location        string       `xml:"location" json:"location"`
+	Website         string       `xml:"website" json:"website"`
+	Joined          string       `xml:"joined" json:"joined"`
+	LastActive      string       `xml:"last_active" json:"last_active"`
+	Interests       string       `xml:"interests" json:"interests"`
+	FavoriteBooks   string       `xml:"favorite_books" json:"favorite_books"`
+	FavoriteAuthors []Author     `xml:"favorite_authors>author" json:"favorite_authors"`
+	UpdatesRSSURL   string       `xml:"updates_rss_url" json:"updates_rss_url"`
+	FriendsCount    int          `xml:"friends_count" json:"friends_count"`
+	GroupsCount     int          `xml:"groups_count" json:"groups_count"`
+	ReviewCount     int          `xml:"reviews_count" json:"reviews_count"`
+	UserShelves     []UserShelf  `xml:"user_shelves>user_shelf" json:"user_shelves"`
+	Updates         []Update     `xml:"updates>update" json:"updates"`
+	UserStatuses    []UserStatus `xml:"user_statuses>user_status" json:"user_statuses"`
+
+	// Private is set when the user has restricted their profile, in which
+	// case their shelves, updates and statuses are omitted by Goodreads.
+	Private bool `xml:"private" json:"private"`
 }
 
 type UserShelf struct {
-	ID            string `xml:"id"`
-	Name          string `xml:"name"`
-	BookCount     string `xml:"book_count"`
-	ExclusiveFlag bool   `xml:"exclusive_flag"`
-	Description   string `xml:"description"`
+	ID            string `xml:"id" json:"id"`
+	Name          string `xml:"name" json:"name"`
+	BookCount     string `xml:"book_count" json:"book_count"`
+	ExclusiveFlag bool   `xml:"exclusive_flag" json:"exclusive_flag"`
+	SortableFlag  bool   `xml:"sortable_flag" json:"sortable_flag"`
+	Description   string `xml:"description" json:"description"`
+	Featured      bool   `xml:"featured" json:"featured"`
+	RecommendFor  bool   `xml:"recommend_for" json:"recommend_for"`
+}
+
+// Topic defines a discussion thread within a group or about a book.
+type Topic struct {
+	ID            string      `xml:"id" json:"id"`
+	Title         string      `xml:"title" json:"title"`
+	SubjectType   string      `xml:"subject_type" json:"subject_type"`
+	SubjectID     string      `xml:"subject_id" json:"subject_id"`
+	CommentsCount int         `xml:"comments_count" json:"comments_count"`
+	LastCommentAt string      `xml:"last_comment_at" json:"last_comment_at"`
+	CreatedAt     string      `xml:"created_at" json:"created_at"`
+	UpdatedAt     string      `xml:"updated_at" json:"updated_at"`
+	Folder        GroupFolder `xml:"folder" json:"folder"`
+	Author        User        `xml:"author_user" json:"author_user"`
+	Comments      []Comment   `xml:"comments>comment" json:"comments"`
+}
+
+// The types of updates that may be found in a feed, as indicated by Update.Type.
+const (
+	UpdateTypeReview     = "review"
+	UpdateTypeRating     = "rating"
+	UpdateTypeReadStatus = "readstatus"
+	UpdateTypeUserStatus = "userstatus"
+)
+
+// Update defines an item in a feed of user activity. The Type indicates
+// which kind of activity the update describes, and the matching one of
+// Review, ReadStatus or UserStatus is set when the object is included.
+type Update struct {
+	Type       string      `xml:"type,attr" json:"type"`
+	ActionText string      `xml:"action_text" json:"action_text"`
+	Link       string      `xml:"link" json:"link"`
+	ImageURL   string      `xml:"image_url" json:"image_url"`
+	UpdatedAt  string      `xml:"updated_at" json:"updated_at"`
+	Actor      User        `xml:"actor" json:"actor"`
+	Rating     int         `xml:"action>rating" json:"rating"`
+	Review     *Review     `xml:"object>review" json:"review,omitempty"`
+	ReadStatus *ReadStatus `xml:"object>read_status" json:"read_status,omitempty"`
+	UserStatus *UserStatus `xml:"object>user_status" json:"user_status,omitempty"`
+}
+
+// UserStatus defines a user's progress update on a book they are reading.
+type UserStatus struct {
+	ID            string     `xml:"id" json:"id"`
+	UserID        UserID     `xml:"user_id" json:"user_id"`
+	BookID        BookID     `xml:"book_id" json:"book_id"`
+	Page          int        `xml:"page" json:"page"`
+	Percent       int        `xml:"percent" json:"percent"`
+	Body          string     `xml:"body" json:"body"`
+	CommentsCount int        `xml:"comments_count" json:"comments_count"`
+	CreatedAt     string     `xml:"created_at" json:"created_at"`
+	UpdatedAt     string     `xml:"updated_at" json:"updated_at"`
+	Book          AuthorBook `xml:"book" json:"book"`
+	User          User       `xml:"user" json:"user"`
+}
+
+// ProgressPercent returns the percentage of the book completed at the
+// time of the update. When the update was posted as a page number, the
+// percentage is derived from the number of pages in the book, and zero
+// is returned if that is unknown.
+func (s UserStatus) ProgressPercent() float64 {
+	if s.Percent > 0 {
+		return float64(s.Percent)
+	}
+	if s.Page > 0 && s.Book.NumPages > 0 {
+		return float64(s.Page) / float64(s.Book.NumPages) * 100
+	}
+	return 0
+}
+
+// Work defines a book independently of its editions, along with the
+// ratings of every edition combined and its best known edition.
+type Work struct {
+	ID                       WorkID             `xml:"id" json:"id"`
+	BooksCount               int                `xml:"books_count" json:"books_count"`
+	RatingsCount             int                `xml:"ratings_count" json:"ratings_count"`
+	TextReviewsCount         int                `xml:"text_reviews_count" json:"text_reviews_count"`
+	OriginalPublicationYear  int                `xml:"original_publication_year" json:"original_publication_year"`
+	OriginalPublicationMonth int                `xml:"original_publication_month" json:"original_publication_month"`
+	OriginalPublicationDay   int                `xml:"original_publication_day" json:"original_publication_day"`
+	AverageRating            float64            `xml:"average_rating" json:"average_rating"`
+	RatingDist               RatingDistribution `xml:"rating_dist" json:"rating_dist"`
+	BestBook                 AuthorBook         `xml:"best_book" json:"best_book"`
 }
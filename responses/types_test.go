@@ -0,0 +1,222 @@
+package responses
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReview_OnShelf(t *testing.T) {
+	r := Review{
+		Shelves: []ReviewShelf{
+			{Name: "read", Exclusive: true},
+			{Name: "favorites"},
+		},
+	}
+
+	assert.True(t, r.OnShelf("read"))
+	assert.True(t, r.OnShelf("favorites"))
+	assert.False(t, r.OnShelf("to-read"))
+	assert.False(t, Review{}.OnShelf("read"))
+}
+
+func TestUserStatus_ProgressPercent(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Status UserStatus
+		Expect float64
+	}{
+		{"with percent", UserStatus{Percent: 40, Page: 10}, 40},
+		{"with page", UserStatus{Page: 75, Book: AuthorBook{NumPages: 300}}, 25},
+		{"with page and unknown page count", UserStatus{Page: 75}, 0},
+		{"without progress", UserStatus{}, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Expect, tc.Status.ProgressPercent())
+		})
+	}
+}
+
+func TestCompareReviews(t *testing.T) {
+	yours := []Review{
+		{ID: "y1", Book: AuthorBook{ID: "book1"}, Rating: 5},
+		{ID: "y2", Book: AuthorBook{ID: "book2"}, Rating: 3},
+		{ID: "y3", Book: AuthorBook{ID: "book3"}, Rating: 4},
+		{ID: "y4", Book: AuthorBook{ID: "book4"}, Rating: 1},
+	}
+	theirs := []Review{
+		{ID: "t1", Book: AuthorBook{ID: "book1"}, Rating: 4},
+		{ID: "t3", Book: AuthorBook{ID: "book3"}, Rating: 4},
+	}
+
+	c := CompareReviews(yours, theirs)
+	assert.Equal(t, Comparison{
+		YourLibraryPercent:   50,
+		TheirLibraryPercent:  100,
+		YourTotalBooksCount:  4,
+		TheirTotalBooksCount: 2,
+		Books: []ComparedBook{
+			{
+				Book:        AuthorBook{ID: "book1"},
+				YourReview:  ComparedReview{ID: "y1", Rating: 5},
+				TheirReview: ComparedReview{ID: "t1", Rating: 4},
+			},
+			{
+				Book:        AuthorBook{ID: "book3"},
+				YourReview:  ComparedReview{ID: "y3", Rating: 4},
+				TheirReview: ComparedReview{ID: "t3", Rating: 4},
+			},
+		},
+	}, c)
+
+	t.Run("without reviews", func(t *testing.T) {
+		assert.Equal(t, Comparison{}, CompareReviews(nil, nil))
+	})
+}
+
+func TestComparison_Compatibility(t *testing.T) {
+	book := func(yours, theirs int) ComparedBook {
+		return ComparedBook{
+			YourReview:  ComparedReview{Rating: yours},
+			TheirReview: ComparedReview{Rating: theirs},
+		}
+	}
+
+	testCases := []struct {
+		Name   string
+		Books  []ComparedBook
+		Expect float64
+	}{
+		{"with matching ratings", []ComparedBook{book(5, 5), book(2, 2)}, 1},
+		{"with opposite ratings", []ComparedBook{book(5, 1), book(1, 5)}, 0},
+		{"with mixed ratings", []ComparedBook{book(5, 4), book(3, 3)}, 0.875},
+		{"with unrated books", []ComparedBook{book(5, 5), book(0, 1), book(3, 0)}, 1},
+		{"without books", nil, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Expect, Comparison{Books: tc.Books}.Compatibility())
+		})
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	testCases := []struct {
+		Name string
+		XML  string
+		New  func() interface{}
+	}{
+		{
+			"Author",
+			`<author>
+				<id>3354</id>
+				<name>Haruki Murakami</name>
+				<image_url>https://image.jpg</image_url>
+				<average_rating>3.9</average_rating>
+				<works_count>100</works_count>
+				<goodreads_author>true</goodreads_author>
+				<user><user_id>user-id</user_id></user>
+				<books><book><id>9777</id><title>Norwegian Wood</title></book></books>
+			</author>`,
+			func() interface{} { return &Author{} },
+		},
+		{
+			"Review",
+			`<review>
+				<id>review-id</id>
+				<user><id>user-id</id><name>User Name</name></user>
+				<book><id>9777</id><isbn13>9780375704024</isbn13><authors><author><id>3354</id></author></authors></book>
+				<rating>4</rating>
+				<spoiler_flag>true</spoiler_flag>
+				<shelves><shelf name="read" exclusive="true" id="shelf1" /></shelves>
+				<body><![CDATA[Great <b>read</b>.]]></body>
+				<comments><comment><id>comment-id</id><body>Agreed</body></comment></comments>
+			</review>`,
+			func() interface{} { return &Review{} },
+		},
+		{
+			"User",
+			`<user>
+				<id>user-id</id>
+				<name>User Name</name>
+				<updates_rss_url>https://rss</updates_rss_url>
+				<favorite_authors><author><id>3354</id></author></favorite_authors>
+				<user_shelves><user_shelf><id>shelf1</id><exclusive_flag>true</exclusive_flag></user_shelf></user_shelves>
+				<updates>
+					<update type="review"><object><review><id>review-id</id></review></object></update>
+					<update type="userstatus"><object><user_status><id>status-id</id></user_status></object></update>
+				</updates>
+				<private>true</private>
+			</user>`,
+			func() interface{} { return &User{} },
+		},
+		{
+			"Event",
+			`<event>
+				<id>event-id</id>
+				<venue>Local Bookstore</venue>
+				<start_at>2019-08-06T19:00:00Z</start_at>
+				<end_at nil="true" />
+			</event>`,
+			func() interface{} { return &Event{} },
+		},
+		{
+			"Group",
+			`<group>
+				<id>group-id</id>
+				<moderators><group_user><user><id>user-id</id></user></group_user></moderators>
+				<folders><folder><id>folder-id</id></folder></folders>
+			</group>`,
+			func() interface{} { return &Group{} },
+		},
+		{
+			"Comparison",
+			`<compare>
+				<your_library_percent>12.5</your_library_percent>
+				<reviews><review><book><id>9777</id></book><your_review><rating>4</rating></your_review></review></reviews>
+			</compare>`,
+			func() interface{} { return &Comparison{} },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			fromXML := tc.New()
+			assert.Nil(t, xml.Unmarshal([]byte(tc.XML), fromXML))
+
+			b, err := json.Marshal(fromXML)
+			assert.Nil(t, err)
+
+			fromJSON := tc.New()
+			assert.Nil(t, json.Unmarshal(b, fromJSON))
+			assert.Equal(t, fromXML, fromJSON)
+		})
+	}
+}
+
+func TestJSONKeys(t *testing.T) {
+	b, err := json.Marshal(User{
+		ID:            "user-id",
+		ImageURL:      "https://image.jpg",
+		UpdatesRSSURL: "https://rss",
+		Updates:       []Update{{Type: UpdateTypeReview}},
+	})
+	assert.Nil(t, err)
+
+	var keys map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b, &keys))
+	assert.Equal(t, "user-id", keys["id"])
+	assert.Equal(t, "https://image.jpg", keys["image_url"])
+	assert.Equal(t, "https://rss", keys["updates_rss_url"])
+
+	update := keys["updates"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "review", update["type"])
+	assert.NotContains(t, update, "review")
+	assert.NotContains(t, update, "read_status")
+	assert.NotContains(t, update, "user_status")
+}
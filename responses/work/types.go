@@ -1,26 +1,96 @@
 package work
 
+import (
+	"strconv"
+
+	"github.com/KyleBanks/goodreads/responses"
+)
+
+// Work is a book as returned by SearchBooks.
+//
+// Deprecated: Work duplicates responses.Work, which uses typed IDs and
+// a responses.AuthorBook for its best book. Use ToWork to convert it.
 type Work struct {
-	ID                       int     `xml:"id"`
-	BooksCount               int     `xml:"books_count"`
-	RatingsCount             int     `xml:"ratings_count"`
-	TextReviewsCount         int     `xml:"text_reviews_count"`
-	OriginalPublicationYear  int     `xml:"original_publication_year"`
-	OriginalPublicationMonth int     `xml:"original_publication_month"`
-	OriginalPublicationDay   int     `xml:"original_publication_day"`
-	AverageRating            float64 `xml:"average_rating"`
-	BestBook                 Book    `xml:"best_book"`
+	ID                       int                          `xml:"id" json:"id"`
+	BooksCount               int                          `xml:"books_count" json:"books_count"`
+	RatingsCount             int                          `xml:"ratings_count" json:"ratings_count"`
+	TextReviewsCount         int                          `xml:"text_reviews_count" json:"text_reviews_count"`
+	OriginalPublicationYear  int                          `xml:"original_publication_year" json:"original_publication_year"`
+	OriginalPublicationMonth int                          `xml:"original_publication_month" json:"original_publication_month"`
+	OriginalPublicationDay   int                          `xml:"original_publication_day" json:"original_publication_day"`
+	AverageRating            float64                      `xml:"average_rating" json:"average_rating"`
+	RatingDist               responses.RatingDistribution `xml:"rating_dist" json:"rating_dist"`
+	BestBook                 Book                         `xml:"best_book" json:"best_book"`
+}
+
+// WorkID returns the ID of the work.
+func (w Work) WorkID() responses.WorkID {
+	return responses.WorkID(formatID(w.ID))
+}
+
+// ToWork converts the Work to a responses.Work.
+func (w Work) ToWork() responses.Work {
+	return responses.Work{
+		ID:                       w.WorkID(),
+		BooksCount:               w.BooksCount,
+		RatingsCount:             w.RatingsCount,
+		TextReviewsCount:         w.TextReviewsCount,
+		OriginalPublicationYear:  w.OriginalPublicationYear,
+		OriginalPublicationMonth: w.OriginalPublicationMonth,
+		OriginalPublicationDay:   w.OriginalPublicationDay,
+		AverageRating:            w.AverageRating,
+		RatingDist:               w.RatingDist,
+		BestBook:                 w.BestBook.ToAuthorBook(),
+	}
 }
 
+// Book is the best known edition of a Work.
+//
+// Deprecated: Book duplicates responses.AuthorBook, which is the best
+// book of a responses.Work. Use ToAuthorBook to convert it.
 type Book struct {
-	ID            int    `xml:"id"`
-	Title         string `xml:"title"`
-	Author        Author `xml:"author"`
-	ImageURL      string `xml:"image_url"`
-	SmallImageURL string `xml:"small_image_url"`
+	ID            int    `xml:"id" json:"id"`
+	Title         string `xml:"title" json:"title"`
+	Author        Author `xml:"author" json:"author"`
+	ImageURL      string `xml:"image_url" json:"image_url"`
+	SmallImageURL string `xml:"small_image_url" json:"small_image_url"`
 }
 
+// ToAuthorBook converts the Book to a responses.AuthorBook.
+func (b Book) ToAuthorBook() responses.AuthorBook {
+	ab := responses.AuthorBook{
+		ID:            formatID(b.ID),
+		Title:         b.Title,
+		ImageURL:      b.ImageURL,
+		SmallImageURL: b.SmallImageURL,
+	}
+	if b.Author != (Author{}) {
+		ab.Authors = []responses.Author{b.Author.ToAuthor()}
+	}
+	return ab
+}
+
+// Author is the author of a Book.
+//
+// Deprecated: Author duplicates responses.Author, which is used by
+// responses.AuthorBook. Use ToAuthor to convert it.
 type Author struct {
-	ID   int    `xml:"id"`
-	Name string `xml:"name"`
+	ID   int    `xml:"id" json:"id"`
+	Name string `xml:"name" json:"name"`
+}
+
+// ToAuthor converts the Author to a responses.Author.
+func (a Author) ToAuthor() responses.Author {
+	return responses.Author{
+		ID:   formatID(a.ID),
+		Name: a.Name,
+	}
+}
+
+// formatID returns the string form of an ID, where zero means the ID is missing.
+func formatID(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
 }
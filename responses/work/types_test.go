@@ -0,0 +1,97 @@
+package work
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/KyleBanks/goodreads/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWork_WorkID(t *testing.T) {
+	assert.Equal(t, responses.WorkID("4835472"), Work{ID: 4835472}.WorkID())
+}
+
+func TestWork_ToWork(t *testing.T) {
+	w := Work{
+		ID:                      4835472,
+		BooksCount:              2,
+		OriginalPublicationYear: 1987,
+		AverageRating:           4.01,
+		BestBook: Book{
+			ID:     9777,
+			Title:  "Norwegian Wood",
+			Author: Author{ID: 3354, Name: "Haruki Murakami"},
+		},
+	}
+
+	assert.Equal(t, responses.Work{
+		ID:                      "4835472",
+		BooksCount:              2,
+		OriginalPublicationYear: 1987,
+		AverageRating:           4.01,
+		BestBook: responses.AuthorBook{
+			ID:      "9777",
+			Title:   "Norwegian Wood",
+			Authors: []responses.Author{{ID: "3354", Name: "Haruki Murakami"}},
+		},
+	}, w.ToWork())
+
+	t.Run("without author", func(t *testing.T) {
+		w := Work{BestBook: Book{Title: "Norwegian Wood"}}
+		assert.Equal(t, responses.Work{
+			BestBook: responses.AuthorBook{Title: "Norwegian Wood"},
+		}, w.ToWork())
+		assert.Equal(t, responses.WorkID(""), w.WorkID())
+	})
+}
+
+func TestBook_ToAuthorBook(t *testing.T) {
+	b := Book{
+		ID:            9777,
+		Title:         "Norwegian Wood",
+		Author:        Author{ID: 3354, Name: "Haruki Murakami"},
+		ImageURL:      "https://image.jpg",
+		SmallImageURL: "https://small_image.jpg",
+	}
+
+	assert.Equal(t, responses.AuthorBook{
+		ID:            "9777",
+		Title:         "Norwegian Wood",
+		ImageURL:      "https://image.jpg",
+		SmallImageURL: "https://small_image.jpg",
+		Authors: []responses.Author{
+			{ID: "3354", Name: "Haruki Murakami"},
+		},
+	}, b.ToAuthorBook())
+}
+
+func TestAuthor_ToAuthor(t *testing.T) {
+	a := Author{ID: 3354, Name: "Haruki Murakami"}
+	assert.Equal(t, responses.Author{ID: "3354", Name: "Haruki Murakami"}, a.ToAuthor())
+}
+
+func TestWork_JSONRoundTrip(t *testing.T) {
+	var fromXML Work
+	err := xml.Unmarshal([]byte(`<work>
+		<id type="integer">1</id>
+		<books_count type="integer">2</books_count>
+		<original_publication_month type="integer" nil="true" />
+		<average_rating>3.59</average_rating>
+		<best_book type="Book">
+			<id type="integer">1</id>
+			<title>book1</title>
+			<author><id type="integer">1</id><name>Author 1</name></author>
+		</best_book>
+	</work>`), &fromXML)
+	assert.Nil(t, err)
+
+	b, err := json.Marshal(fromXML)
+	assert.Nil(t, err)
+	assert.Contains(t, string(b), `"best_book":{"id":1,"title":"book1","author":{"id":1,"name":"Author 1"}`)
+
+	var fromJSON Work
+	assert.Nil(t, json.Unmarshal(b, &fromJSON))
+	assert.Equal(t, fromXML, fromJSON)
+}